@@ -0,0 +1,86 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/components"
+)
+
+// ComponentDefinitionSpec describes a component shipped outside of the operator, which DataScienceClusters deploy
+// like the built-in ones.
+type ComponentDefinitionSpec struct {
+	// managementState, devFlags and preservedFields apply to the component in every DataScienceCluster.
+	// devFlags manifests take precedence over manifests.
+	components.Component `json:",inline"`
+
+	// Manifests of the component, rendered with kustomize. The uri can refer to a tarball over HTTP(S), to an OCI
+	// artifact, to a directory available in the operator container, or to a ConfigMap holding the files of a kustomization,
+	// e.g. configmap://<namespace>/<name>.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=1
+	Manifests components.ManifestsConfig `json:"manifests"`
+
+	// Namespace the component is deployed to. Defaults to the applications namespace of each DataScienceCluster.
+	// +optional
+	// +kubebuilder:validation:Pattern="^([a-z0-9]([-a-z0-9]*[a-z0-9])?)?$"
+	// +kubebuilder:validation:MaxLength=63
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=2
+	TargetNamespace string `json:"targetNamespace,omitempty"`
+
+	// Images of the component, by name of the param of params.env at the root of the manifests holding them.
+	// A namespace param is set to the namespace the component is deployed to.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=3
+	ImageParams map[string]string `json:"imageParams,omitempty"`
+
+	// Names of the Deployments which have to be available in the target namespace for the component to be ready.
+	// Defaults to the Deployments and StatefulSets deployed from the manifests.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=4
+	ReadinessDeployments []string `json:"readinessDeployments,omitempty"`
+
+	// Names of the components, built-in or external, which have to be reconciled before this one.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=5
+	Dependencies []string `json:"dependencies,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:resource:scope=Cluster,shortName=compdef
+
+// ComponentDefinition declares an external component, deployed and reported in the status of each DataScienceCluster
+// under the name of the ComponentDefinition. Deleting it removes the component from the DataScienceClusters first.
+type ComponentDefinition struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ComponentDefinitionSpec `json:"spec,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ComponentDefinitionList contains a list of ComponentDefinition.
+type ComponentDefinitionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ComponentDefinition `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ComponentDefinition{}, &ComponentDefinitionList{})
+}
@@ -0,0 +1,79 @@
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/components"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+)
+
+// Hub marks v1 as the version other versions of DataScienceCluster are converted to and from. It is the storage version,
+// and the one the operator reconciles.
+func (d *DataScienceCluster) Hub() {}
+
+// SetupWebhookWithManager registers the conversion webhook of DataScienceCluster.
+func (d *DataScienceCluster) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(d).
+		Complete()
+}
+
+// ComponentsConfig returns the workload configuration of components set with the v2 API, keyed by component name.
+func (d *DataScienceCluster) ComponentsConfig() (map[string]components.Config, error) {
+	configs := map[string]components.Config{}
+	value, found := d.GetAnnotations()[annotations.ComponentsConfig]
+	if !found {
+		return configs, nil
+	}
+	if err := json.Unmarshal([]byte(value), &configs); err != nil {
+		return nil, fmt.Errorf("invalid %s annotation: %w", annotations.ComponentsConfig, err)
+	}
+
+	return configs, nil
+}
+
+// ComponentConfig returns the workload configuration of the given component, nil if none is set.
+func (d *DataScienceCluster) ComponentConfig(componentName string) (*components.Config, error) {
+	configs, err := d.ComponentsConfig()
+	if err != nil {
+		return nil, err
+	}
+	config, found := configs[componentName]
+	if !found {
+		return nil, nil
+	}
+
+	return &config, nil
+}
+
+// SetComponentsConfig stores the workload configuration of components, removing empty ones.
+func (d *DataScienceCluster) SetComponentsConfig(configs map[string]components.Config) error {
+	// Annotations may be shared with the object converted from, so they are copied before being changed
+	objectAnnotations := maps.Clone(d.GetAnnotations())
+	delete(objectAnnotations, annotations.ComponentsConfig)
+
+	nonEmpty := map[string]components.Config{}
+	for name, config := range configs {
+		config := config
+		if !config.IsEmpty() {
+			nonEmpty[name] = config
+		}
+	}
+	if len(nonEmpty) != 0 {
+		value, err := json.Marshal(nonEmpty)
+		if err != nil {
+			return err
+		}
+		if objectAnnotations == nil {
+			objectAnnotations = map[string]string{}
+		}
+		objectAnnotations[annotations.ComponentsConfig] = string(value)
+	}
+	d.SetAnnotations(objectAnnotations)
+
+	return nil
+}
@@ -17,8 +17,7 @@ limitations under the License.
 package v1
 
 import (
-	"errors"
-	"reflect"
+	"fmt"
 
 	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -44,6 +43,13 @@ type DataScienceClusterSpec struct {
 	// Override and fine tune specific component configurations.
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=1
 	Components Components `json:"components,omitempty"`
+
+	// Namespace the components are deployed to, which can not be changed once the DataScienceCluster is created.
+	// Defaults to the applicationsNamespace of the DSCInitialization, which only one DataScienceCluster can use.
+	// Each DataScienceCluster needs its own namespace, created along with the one of the DSCInitialization.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=2
+	// +optional
+	ApplicationsNamespace string `json:"applicationsNamespace,omitempty"`
 }
 
 type Components struct {
@@ -86,6 +92,17 @@ type Components struct {
 	TrainingOperator trainingoperator.TrainingOperator `json:"trainingoperator,omitempty"`
 }
 
+// Component returns the configuration of the registered component defined by the given field, nil if there is none.
+func (c *Components) Component(field string) components.ComponentInterface {
+	registration, found := components.LookupField(field)
+	if !found {
+		return nil
+	}
+	component, _ := registration.Spec(c).(components.ComponentInterface)
+
+	return component
+}
+
 // DataScienceClusterStatus defines the observed state of DataScienceCluster.
 type DataScienceClusterStatus struct {
 	// Phase describes the Phase of DataScienceCluster reconciliation state
@@ -105,10 +122,125 @@ type DataScienceClusterStatus struct {
 	// List of components with status if installed or not
 	InstalledComponents map[string]bool `json:"installedComponents,omitempty"`
 
+	// Components describes the last reconciliation of each component, keyed by component name.
+	// +optional
+	Components map[string]ComponentStatus `json:"components,omitempty"`
+
+	// Plan lists the changes the operator would perform, computed while the DataScienceCluster
+	// is annotated with opendatahub.io/plan-only set to "true".
+	// +optional
+	Plan *Plan `json:"plan,omitempty"`
+
 	// Version and release type
 	Release cluster.Release `json:"release,omitempty"`
 }
 
+// ManifestsSourceType describes where the manifests of a component come from.
+type ManifestsSourceType string
+
+const (
+	// ManifestsSourceBuiltIn means the manifests shipped with the operator are used, including once DevFlags manifests are removed.
+	ManifestsSourceBuiltIn ManifestsSourceType = "BuiltIn"
+	// ManifestsSourceDevFlags means the manifests are downloaded from the URIs set in the component DevFlags.
+	ManifestsSourceDevFlags ManifestsSourceType = "DevFlags"
+	// ManifestsSourceComponentDefinition means the manifests of an external component are downloaded from the URI set in
+	// its ComponentDefinition.
+	ManifestsSourceComponentDefinition ManifestsSourceType = "ComponentDefinition"
+)
+
+// ManifestsSource describes the manifests used to deploy a component.
+type ManifestsSource struct {
+	// Type of the manifests source, either BuiltIn, DevFlags or ComponentDefinition.
+	Type ManifestsSourceType `json:"type,omitempty"`
+	// URIs of the custom manifests, set when Type is DevFlags or ComponentDefinition.
+	// +optional
+	URIs []string `json:"uris,omitempty"`
+}
+
+// ComponentStatus describes the outcome of the last reconciliation of a component.
+type ComponentStatus struct {
+	// ObservedGeneration is the generation of the DataScienceCluster the component has been reconciled against.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// ManifestsSource describes which manifests have been used to deploy the component.
+	ManifestsSource ManifestsSource `json:"manifestsSource,omitempty"`
+
+	// Images lists the container images of the workloads deployed for the component,
+	// as resolved from the manifests params and RELATED_IMAGE_* variables.
+	// +optional
+	Images []string `json:"images,omitempty"`
+
+	// LastReconcileTime is the time the component has been reconciled for the last time.
+	// +optional
+	LastReconcileTime *metav1.Time `json:"lastReconcileTime,omitempty"`
+
+	// LastError holds the error of the last reconciliation, empty if the reconciliation succeeded.
+	// +optional
+	LastError string `json:"lastError,omitempty"`
+
+	// Objects is the list of objects applied for the component during the last reconciliation.
+	// +optional
+	Objects []corev1.ObjectReference `json:"objects,omitempty"`
+
+	// Workloads lists the Deployments and StatefulSets the readiness of the component depends on, with their health,
+	// which is updated as they change.
+	// +optional
+	Workloads []WorkloadStatus `json:"workloads,omitempty"`
+}
+
+// WorkloadStatus describes the health of a Deployment or StatefulSet of a component.
+type WorkloadStatus struct {
+	// Kind of the workload, either Deployment or StatefulSet.
+	Kind string `json:"kind"`
+	// Namespace of the workload.
+	Namespace string `json:"namespace,omitempty"`
+	// Name of the workload.
+	Name string `json:"name"`
+	// Ready tells whether the rollout of the workload is complete and all its replicas are available.
+	Ready bool `json:"ready"`
+	// Replicas is the desired number of replicas of the workload.
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+	// AvailableReplicas is the number of available replicas of the workload.
+	// +optional
+	AvailableReplicas int32 `json:"availableReplicas,omitempty"`
+	// Message explains why the workload is not ready, e.g. a stuck rollout or crash looping pods.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// Plan describes changes computed by a dry-run reconciliation of the DataScienceCluster.
+type Plan struct {
+	// ObservedGeneration is the generation of the DataScienceCluster the plan has been computed for.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// GeneratedAt is the time the plan has been computed.
+	GeneratedAt metav1.Time `json:"generatedAt,omitempty"`
+	// Changes lists objects which would be created, updated or deleted.
+	// +optional
+	Changes []PlannedChange `json:"changes,omitempty"`
+	// Errors lists problems which prevented computing the complete plan for some components.
+	// +optional
+	Errors []string `json:"errors,omitempty"`
+}
+
+// PlannedChange describes a change the operator would perform on a single object.
+type PlannedChange struct {
+	// Action is the kind of the change. Wait only informs about a step of the component waiting for the
+	// cluster, e.g. for workloads to be ready.
+	// +kubebuilder:validation:Enum=Create;Update;Delete;Wait
+	Action string `json:"action"`
+	// Component which manages the object.
+	Component string `json:"component,omitempty"`
+	// Object which would be changed.
+	Object corev1.ObjectReference `json:"object"`
+	// Fields lists paths of the fields which would be changed by an update.
+	// +optional
+	Fields []string `json:"fields,omitempty"`
+	// Message gives additional details about the change.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 //+kubebuilder:resource:scope=Cluster,shortName=dsc
@@ -136,22 +268,27 @@ func init() {
 	SchemeBuilder.Register(&DataScienceCluster{}, &DataScienceClusterList{})
 }
 
-func (d *DataScienceCluster) GetComponents() ([]components.ComponentInterface, error) {
-	var allComponents []components.ComponentInterface
+// GetApplicationsNamespace returns the namespace the components are deployed to, given the applications namespace of
+// the DSCInitialization.
+func (d *DataScienceCluster) GetApplicationsNamespace(dsciApplicationsNamespace string) string {
+	if d.Spec.ApplicationsNamespace != "" {
+		return d.Spec.ApplicationsNamespace
+	}
 
-	c := &d.Spec.Components
+	return dsciApplicationsNamespace
+}
 
-	definedComponents := reflect.ValueOf(c).Elem()
-	for i := 0; i < definedComponents.NumField(); i++ {
-		c := definedComponents.Field(i)
-		if c.CanAddr() {
-			component, ok := c.Addr().Interface().(components.ComponentInterface)
-			if !ok {
-				return allComponents, errors.New("this is not a pointer to ComponentInterface")
-			}
+// GetComponents returns the components registered in the operator, in the order of their registration names.
+func (d *DataScienceCluster) GetComponents() ([]components.ComponentInterface, error) {
+	var allComponents []components.ComponentInterface
 
-			allComponents = append(allComponents, component)
+	for _, registration := range components.Registrations() {
+		component := d.Spec.Components.Component(registration.Field)
+		if component == nil {
+			return allComponents, fmt.Errorf("component %s is registered with field %s, which is not defined in DataScienceCluster",
+				registration.Name, registration.Field)
 		}
+		allComponents = append(allComponents, component)
 	}
 
 	return allComponents, nil
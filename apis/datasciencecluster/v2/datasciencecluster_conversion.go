@@ -0,0 +1,89 @@
+package v2
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/datasciencecluster/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/components"
+)
+
+// componentBlock pairs the configuration of a component in both versions.
+type componentBlock struct {
+	name      string
+	spec      *ComponentSpec
+	component *components.Component
+}
+
+// componentBlocks lists the registered components defined in both versions.
+func componentBlocks(c *Components, hub *dscv1.Components) []componentBlock {
+	var blocks []componentBlock
+	for _, registration := range components.Registrations() {
+		spec, hubComponent := c.Component(registration.Field), hub.Component(registration.Field)
+		if spec == nil || hubComponent == nil {
+			continue
+		}
+		blocks = append(blocks, componentBlock{registration.Name, spec, hubComponent.GetCommon()})
+	}
+
+	return blocks
+}
+
+// ConvertTo converts to v1, the workload configuration of components being kept in an annotation.
+func (d *DataScienceCluster) ConvertTo(dstRaw conversion.Hub) error {
+	dst, ok := dstRaw.(*dscv1.DataScienceCluster)
+	if !ok {
+		return fmt.Errorf("unsupported conversion to %T", dstRaw)
+	}
+	dst.ObjectMeta = d.ObjectMeta
+	dst.Spec.ApplicationsNamespace = d.Spec.ApplicationsNamespace
+	dst.Status = d.Status
+
+	configs := map[string]components.Config{}
+	for _, block := range componentBlocks(&d.Spec.Components, &dst.Spec.Components) {
+		*block.component = components.Component{
+			ManagementState: block.spec.ManagementState,
+			DevFlags:        block.spec.DevFlags,
+			PreservedFields: block.spec.PreservedFields,
+		}
+		configs[block.name] = block.spec.Config
+	}
+	dst.Spec.Components.Kserve.Serving = d.Spec.Components.Kserve.Serving
+	dst.Spec.Components.Kserve.DefaultDeploymentMode = d.Spec.Components.Kserve.DefaultDeploymentMode
+
+	return dst.SetComponentsConfig(configs)
+}
+
+// ConvertFrom converts from v1, restoring the workload configuration of components from its annotation.
+func (d *DataScienceCluster) ConvertFrom(srcRaw conversion.Hub) error {
+	src, ok := srcRaw.(*dscv1.DataScienceCluster)
+	if !ok {
+		return fmt.Errorf("unsupported conversion from %T", srcRaw)
+	}
+	configs, err := src.ComponentsConfig()
+	if err != nil {
+		return err
+	}
+
+	hub := src.DeepCopy()
+	if err := hub.SetComponentsConfig(nil); err != nil {
+		return err
+	}
+	d.ObjectMeta = hub.ObjectMeta
+	d.Spec.ApplicationsNamespace = hub.Spec.ApplicationsNamespace
+	d.Status = hub.Status
+
+	for _, block := range componentBlocks(&d.Spec.Components, &hub.Spec.Components) {
+		*block.spec = ComponentSpec{
+			ManagementState: block.component.ManagementState,
+			DevFlags:        block.component.DevFlags,
+			PreservedFields: block.component.PreservedFields,
+			Config:          configs[block.name],
+		}
+	}
+	d.Spec.Components.Kserve.Serving = hub.Spec.Components.Kserve.Serving
+	d.Spec.Components.Kserve.DefaultDeploymentMode = hub.Spec.Components.Kserve.DefaultDeploymentMode
+
+	return nil
+}
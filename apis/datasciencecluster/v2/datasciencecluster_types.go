@@ -0,0 +1,167 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	operatorv1 "github.com/openshift/api/operator/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/datasciencecluster/v1"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/components"
+	"github.com/opendatahub-io/opendatahub-operator/v2/components/kserve"
+)
+
+// DataScienceClusterSpec defines the desired state of the cluster.
+type DataScienceClusterSpec struct {
+	// Override and fine tune specific component configurations.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=1
+	Components Components `json:"components,omitempty"`
+
+	// Namespace the components are deployed to, which can not be changed once the DataScienceCluster is created.
+	// Defaults to the applicationsNamespace of the DSCInitialization, which only one DataScienceCluster can use.
+	// Each DataScienceCluster needs its own namespace, created along with the one of the DSCInitialization.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=2
+	// +optional
+	ApplicationsNamespace string `json:"applicationsNamespace,omitempty"`
+}
+
+// ComponentSpec is the configuration common to all components. Fields added here are available for every component.
+type ComponentSpec struct {
+	// Set to one of the following values:
+	//
+	// - "Managed" : the operator is actively managing the component and trying to keep it active.
+	//               It will only upgrade the component if it is safe to do so
+	//
+	// - "Removed" : the operator is actively managing the component and will not install it,
+	//               or if it is installed, the operator will try to remove it
+	//
+	// +kubebuilder:validation:Enum=Managed;Removed
+	ManagementState operatorv1.ManagementState `json:"managementState,omitempty"`
+
+	// Add developer fields
+	// +optional
+	DevFlags *components.DevFlags `json:"devFlags,omitempty"`
+
+	// Fields of the component objects which the operator does not overwrite once the objects exist,
+	// in addition to the ones listed in DSCInitialization.
+	// +optional
+	PreservedFields []infrav1.FieldPreservationPolicy `json:"preservedFields,omitempty"`
+
+	// Configuration of the workloads of the component.
+	components.Config `json:",inline"`
+}
+
+func (s *ComponentSpec) componentSpec() *ComponentSpec {
+	return s
+}
+
+// KserveSpec is the configuration of Kserve.
+type KserveSpec struct {
+	ComponentSpec `json:",inline"`
+
+	// Serving configures the KNative-Serving stack used for model serving. A Service
+	// Mesh (Istio) is prerequisite, since it is used as networking layer.
+	Serving infrav1.ServingSpec `json:"serving,omitempty"`
+
+	// Configures the default deployment mode for Kserve. This can be set to 'Serverless' or 'RawDeployment'.
+	// If no default deployment mode is specified, Kserve will use Serverless mode, or RawDeployment
+	// when serving is Removed.
+	// +kubebuilder:validation:Enum=Serverless;RawDeployment
+	DefaultDeploymentMode kserve.DefaultDeploymentMode `json:"defaultDeploymentMode,omitempty"`
+}
+
+type Components struct {
+	// Dashboard component configuration.
+	Dashboard ComponentSpec `json:"dashboard,omitempty"`
+
+	// Workbenches component configuration.
+	Workbenches ComponentSpec `json:"workbenches,omitempty"`
+
+	// ModelMeshServing component configuration.
+	// Does not support enabled Kserve at the same time
+	ModelMeshServing ComponentSpec `json:"modelmeshserving,omitempty"`
+
+	// DataServicePipeline component configuration.
+	// Require OpenShift Pipelines Operator to be installed before enable component
+	DataSciencePipelines ComponentSpec `json:"datasciencepipelines,omitempty"`
+
+	// Kserve component configuration.
+	// Require OpenShift Serverless and OpenShift Service Mesh Operators to be installed before enable component
+	// Does not support enabled ModelMeshServing at the same time
+	Kserve KserveSpec `json:"kserve,omitempty"`
+
+	// Kueue component configuration.
+	Kueue ComponentSpec `json:"kueue,omitempty"`
+
+	// CodeFlare component configuration.
+	// If CodeFlare Operator has been installed in the cluster, it should be uninstalled first before enabled component.
+	CodeFlare ComponentSpec `json:"codeflare,omitempty"`
+
+	// Ray component configuration.
+	Ray ComponentSpec `json:"ray,omitempty"`
+
+	// TrustyAI component configuration.
+	TrustyAI ComponentSpec `json:"trustyai,omitempty"`
+
+	// ModelRegistry component configuration.
+	ModelRegistry ComponentSpec `json:"modelregistry,omitempty"`
+
+	// Training Operator component configuration.
+	TrainingOperator ComponentSpec `json:"trainingoperator,omitempty"`
+}
+
+// Component returns the configuration of the registered component defined by the given field, nil if there is none.
+func (c *Components) Component(field string) *ComponentSpec {
+	registration, found := components.LookupField(field)
+	if !found {
+		return nil
+	}
+	// components with their own fields, such as KServe, embed ComponentSpec
+	component, ok := registration.Spec(c).(interface{ componentSpec() *ComponentSpec })
+	if !ok {
+		return nil
+	}
+
+	return component.componentSpec()
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:resource:scope=Cluster,shortName=dsc
+
+// DataScienceCluster is the Schema for the datascienceclusters API.
+type DataScienceCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DataScienceClusterSpec         `json:"spec,omitempty"`
+	Status dscv1.DataScienceClusterStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// DataScienceClusterList contains a list of DataScienceCluster.
+type DataScienceClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DataScienceCluster `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DataScienceCluster{}, &DataScienceClusterList{})
+}
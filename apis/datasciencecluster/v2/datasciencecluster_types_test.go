@@ -0,0 +1,39 @@
+package v2_test
+
+import (
+	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/datasciencecluster/v1"
+	dscv2 "github.com/opendatahub-io/opendatahub-operator/v2/apis/datasciencecluster/v2"
+	"github.com/opendatahub-io/opendatahub-operator/v2/components"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Components of DataScienceCluster", func() {
+
+	It("should resolve the configuration of every registered component in both versions", func() {
+		v1Components := &dscv1.Components{}
+		v2Components := &dscv2.Components{}
+
+		Expect(components.Registrations()).ToNot(BeEmpty())
+		for _, registration := range components.Registrations() {
+			Expect(v1Components.Component(registration.Field)).ToNot(BeNil(), "v1 component %s", registration.Field)
+			Expect(v2Components.Component(registration.Field)).ToNot(BeNil(), "v2 component %s", registration.Field)
+		}
+	})
+
+	It("should resolve the fields of the components", func() {
+		v1Components := &dscv1.Components{}
+		v2Components := &dscv2.Components{}
+
+		Expect(v1Components.Component("dashboard")).To(BeIdenticalTo(&v1Components.Dashboard))
+		Expect(v1Components.Component("kserve")).To(BeIdenticalTo(&v1Components.Kserve))
+		Expect(v2Components.Component("dashboard")).To(BeIdenticalTo(&v2Components.Dashboard))
+		Expect(v2Components.Component("kserve")).To(BeIdenticalTo(&v2Components.Kserve.ComponentSpec))
+	})
+
+	It("should not resolve fields which are not registered components", func() {
+		Expect((&dscv1.Components{}).Component("unknown")).To(BeNil())
+		Expect((&dscv2.Components{}).Component("unknown")).To(BeNil())
+	})
+})
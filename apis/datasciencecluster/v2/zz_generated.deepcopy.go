@@ -0,0 +1,174 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v2
+
+import (
+	"github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/components"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentSpec) DeepCopyInto(out *ComponentSpec) {
+	*out = *in
+	if in.DevFlags != nil {
+		in, out := &in.DevFlags, &out.DevFlags
+		*out = new(components.DevFlags)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PreservedFields != nil {
+		in, out := &in.PreservedFields, &out.PreservedFields
+		*out = make([]v1.FieldPreservationPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.Config.DeepCopyInto(&out.Config)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentSpec.
+func (in *ComponentSpec) DeepCopy() *ComponentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Components) DeepCopyInto(out *Components) {
+	*out = *in
+	in.Dashboard.DeepCopyInto(&out.Dashboard)
+	in.Workbenches.DeepCopyInto(&out.Workbenches)
+	in.ModelMeshServing.DeepCopyInto(&out.ModelMeshServing)
+	in.DataSciencePipelines.DeepCopyInto(&out.DataSciencePipelines)
+	in.Kserve.DeepCopyInto(&out.Kserve)
+	in.Kueue.DeepCopyInto(&out.Kueue)
+	in.CodeFlare.DeepCopyInto(&out.CodeFlare)
+	in.Ray.DeepCopyInto(&out.Ray)
+	in.TrustyAI.DeepCopyInto(&out.TrustyAI)
+	in.ModelRegistry.DeepCopyInto(&out.ModelRegistry)
+	in.TrainingOperator.DeepCopyInto(&out.TrainingOperator)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Components.
+func (in *Components) DeepCopy() *Components {
+	if in == nil {
+		return nil
+	}
+	out := new(Components)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataScienceCluster) DeepCopyInto(out *DataScienceCluster) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataScienceCluster.
+func (in *DataScienceCluster) DeepCopy() *DataScienceCluster {
+	if in == nil {
+		return nil
+	}
+	out := new(DataScienceCluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DataScienceCluster) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataScienceClusterList) DeepCopyInto(out *DataScienceClusterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DataScienceCluster, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataScienceClusterList.
+func (in *DataScienceClusterList) DeepCopy() *DataScienceClusterList {
+	if in == nil {
+		return nil
+	}
+	out := new(DataScienceClusterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DataScienceClusterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataScienceClusterSpec) DeepCopyInto(out *DataScienceClusterSpec) {
+	*out = *in
+	in.Components.DeepCopyInto(&out.Components)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataScienceClusterSpec.
+func (in *DataScienceClusterSpec) DeepCopy() *DataScienceClusterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DataScienceClusterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KserveSpec) DeepCopyInto(out *KserveSpec) {
+	*out = *in
+	in.ComponentSpec.DeepCopyInto(&out.ComponentSpec)
+	out.Serving = in.Serving
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KserveSpec.
+func (in *KserveSpec) DeepCopy() *KserveSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KserveSpec)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -30,7 +30,8 @@ import (
 
 // DSCInitializationSpec defines the desired state of DSCInitialization.
 type DSCInitializationSpec struct {
-	// Namespace for applications to be installed, non-configurable, default to "opendatahub"
+	// Namespace for applications to be installed, non-configurable, default to "opendatahub".
+	// It can not be changed once the DSCInitialization is created.
 	// +kubebuilder:default:=opendatahub
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=1
 	ApplicationsNamespace string `json:"applicationsNamespace"`
@@ -57,6 +58,11 @@ type DSCInitializationSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=5
 	// +optional
 	DevFlags *DevFlags `json:"devFlags,omitempty"`
+	// Fields of objects deployed by all components which the operator does not overwrite once the objects exist,
+	// e.g. replicas of Deployments tuned on the cluster. Components can list additional fields in their spec.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=6
+	// +optional
+	PreservedFields []infrav1.FieldPreservationPolicy `json:"preservedFields,omitempty"`
 }
 
 type Monitoring struct {
@@ -68,7 +74,9 @@ type Monitoring struct {
 	// +kubebuilder:validation:Enum=Managed;Removed
 	ManagementState operatorv1.ManagementState `json:"managementState,omitempty"`
 	// +kubebuilder:default=opendatahub
-	// Namespace for monitoring if it is enabled
+	// Namespace for monitoring if it is enabled. It can only be changed along with the
+	// opendatahub.io/migrate-monitoring-namespace annotation set to "true", in which case the
+	// monitoring stack is moved to the new namespace.
 	Namespace string `json:"namespace,omitempty"`
 }
 
@@ -114,6 +122,11 @@ type DSCInitializationStatus struct {
 
 	// Version and release type
 	Release cluster.Release `json:"release,omitempty"`
+
+	// MonitoringNamespace is the namespace the monitoring stack is deployed in, which is cleaned up
+	// once the stack is migrated to another namespace.
+	// +optional
+	MonitoringNamespace string `json:"monitoringNamespace,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -106,6 +106,13 @@ func (in *DSCInitializationSpec) DeepCopyInto(out *DSCInitializationSpec) {
 		*out = new(DevFlags)
 		**out = **in
 	}
+	if in.PreservedFields != nil {
+		in, out := &in.PreservedFields, &out.PreservedFields
+		*out = make([]infrastructurev1.FieldPreservationPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DSCInitializationSpec.
@@ -3,6 +3,7 @@ package v1
 import (
 	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 // FeatureTracker represents a cluster-scoped resource in the Data Science Cluster,
@@ -46,6 +47,8 @@ var ConditionReason = struct {
 	LoadTemplateData,
 	ApplyManifests,
 	PostConditions,
+	DependencyFailed, // the feature is skipped as one of the features it depends on failed
+	Waiting, // the feature waits for the cluster, e.g. for pods to be ready, and is applied again later
 	FeatureCreated FeatureConditionReason
 }{
 	FailedApplying:   "FailedApplying",
@@ -54,12 +57,16 @@ var ConditionReason = struct {
 	LoadTemplateData: "LoadTemplateData",
 	ApplyManifests:   "ApplyManifests",
 	PostConditions:   "PostConditions",
+	DependencyFailed: "DependencyFailed",
+	Waiting:          "Waiting",
 	FeatureCreated:   "FeatureCreated",
 }
 
 const (
 	ComponentType OwnerType = "Component"
 	DSCIType      OwnerType = "DSCI"
+	// DeclaredType is the source of features declared in a ConfigMap, which is the name of the source.
+	DeclaredType OwnerType = "Declared"
 )
 
 func (s *FeatureTracker) ToOwnerReference() metav1.OwnerReference {
@@ -90,6 +97,51 @@ type FeatureTrackerStatus struct {
 	Phase string `json:"phase,omitempty"`
 	// +optional
 	Conditions []conditionsv1.Condition `json:"conditions,omitempty"`
+	// Resources are the objects created or patched by the feature, which are removed along with it.
+	// +optional
+	Resources []TrackedResource `json:"resources,omitempty"`
+}
+
+// TrackedResource references an object created or patched by a feature.
+type TrackedResource struct {
+	Group     string `json:"group,omitempty"`
+	Version   string `json:"version"`
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	// Patch tells whether the object existed and has been patched by the feature, instead of created by it.
+	// +optional
+	Patch bool `json:"patch,omitempty"`
+	// ReversePatch holds the fields changed by the patch as they were before it has been applied, in the form of a JSON
+	// merge patch in which fields added by the patch are null. They are restored when the feature is removed.
+	// +optional
+	ReversePatch string `json:"reversePatch,omitempty"`
+	// PatchedFields are the fields set by the patch, in JSON. Fields are only restored as long as they hold the patched
+	// values, so that changes made since by others are kept.
+	// +optional
+	PatchedFields string `json:"patchedFields,omitempty"`
+	// AppliedFields are hashes of the values of the fields set by the manifests when the object has last been applied,
+	// keyed by their path, e.g. spec.ports[0].port, against which changes made to it are detected. They are not set for
+	// existing objects left as they are, as they are not managed by the operator.
+	// +optional
+	AppliedFields map[string]string `json:"appliedFields,omitempty"`
+	// Managed tells whether the object is managed by the operator, in which case it is applied again when changed.
+	// +optional
+	Managed bool `json:"managed,omitempty"`
+}
+
+// GroupVersionKind returns the kind of the referenced object.
+func (r TrackedResource) GroupVersionKind() schema.GroupVersionKind {
+	return schema.GroupVersionKind{Group: r.Group, Version: r.Version, Kind: r.Kind}
+}
+
+// String describes the referenced object, e.g. "Service istio-system/knative-local-gateway".
+func (r TrackedResource) String() string {
+	if r.Namespace == "" {
+		return r.Kind + " " + r.Name
+	}
+
+	return r.Kind + " " + r.Namespace + "/" + r.Name
 }
 
 // +kubebuilder:object:root=true
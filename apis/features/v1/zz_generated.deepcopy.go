@@ -111,6 +111,13 @@ func (in *FeatureTrackerStatus) DeepCopyInto(out *FeatureTrackerStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]TrackedResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeatureTrackerStatus.
@@ -137,3 +144,25 @@ func (in *Source) DeepCopy() *Source {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrackedResource) DeepCopyInto(out *TrackedResource) {
+	*out = *in
+	if in.AppliedFields != nil {
+		in, out := &in.AppliedFields, &out.AppliedFields
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrackedResource.
+func (in *TrackedResource) DeepCopy() *TrackedResource {
+	if in == nil {
+		return nil
+	}
+	out := new(TrackedResource)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,19 @@
+package v1
+
+// FieldPreservationPolicy lists fields of objects of a given kind which the operator does not overwrite once the objects exist,
+// so they can be tuned on the cluster, e.g. replicas or resources of Deployments.
+type FieldPreservationPolicy struct {
+	// Group of the objects, empty for the core API group.
+	// +optional
+	Group string `json:"group,omitempty"`
+	// Version of the objects. Objects of any version are matched when it is not set.
+	// +optional
+	Version string `json:"version,omitempty"`
+	// Kind of the objects, e.g. Deployment.
+	// +kubebuilder:validation:MinLength=1
+	Kind string `json:"kind"`
+	// Paths of the preserved fields, e.g. .spec.replicas or .spec.template.spec.containers[*].resources.
+	// Items of lists marked with [*] are matched by name, or by position when they do not have a name.
+	// +kubebuilder:validation:MinItems=1
+	Paths []string `json:"paths"`
+}
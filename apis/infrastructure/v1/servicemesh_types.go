@@ -2,6 +2,18 @@ package v1
 
 import operatorv1 "github.com/openshift/api/operator/v1"
 
+// Defaults of the Service Mesh configuration, materialized in DSCInitialization objects by the mutating webhook.
+const (
+	DefaultControlPlaneName      = "data-science-smcp"
+	DefaultControlPlaneNamespace = "istio-system"
+	DefaultMetricsCollection     = "Istio"
+)
+
+// DefaultAuthNamespace returns the namespace of authorization services used when Auth.Namespace is not set.
+func DefaultAuthNamespace(applicationsNamespace string) string {
+	return applicationsNamespace + "-auth-provider"
+}
+
 // ServiceMeshSpec configures Service Mesh.
 type ServiceMeshSpec struct {
 	// +kubebuilder:validation:Enum=Managed;Unmanaged;Removed
@@ -44,7 +56,8 @@ type IngressGatewaySpec struct {
 
 type AuthSpec struct {
 	// Namespace where it is deployed. If not provided, the default is to
-	// use '-auth-provider' suffix on the ApplicationsNamespace of the DSCI.
+	// use '-auth-provider' suffix on the ApplicationsNamespace of the DSCI,
+	// which is set on the object when it is created or updated.
 	Namespace string `json:"namespace,omitempty"`
 	// Audiences is a list of the identifiers that the resource server presented
 	// with the token identifies as. Audience-aware token authenticators will verify
@@ -77,6 +77,26 @@ func (in *ControlPlaneSpec) DeepCopy() *ControlPlaneSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FieldPreservationPolicy) DeepCopyInto(out *FieldPreservationPolicy) {
+	*out = *in
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FieldPreservationPolicy.
+func (in *FieldPreservationPolicy) DeepCopy() *FieldPreservationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(FieldPreservationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IngressGatewaySpec) DeepCopyInto(out *IngressGatewaySpec) {
 	*out = *in
@@ -0,0 +1,322 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// odh-render renders the objects the operator would apply for the given DataScienceCluster and DSCInitialization,
+// using the component manifests from /opt/manifests, without access to a cluster.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-logr/logr"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	routev1 "github.com/openshift/api/route/v1"
+	ofapiv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	ofapiv2 "github.com/operator-framework/api/pkg/operators/v2"
+	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/datasciencecluster/v1"
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/components"
+	"github.com/opendatahub-io/opendatahub-operator/v2/components/dashboard"
+	dscicontr "github.com/opendatahub-io/opendatahub-operator/v2/controllers/dscinitialization"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/logger"
+)
+
+var (
+	scheme = runtime.NewScheme()
+
+	platforms = map[string]cluster.Platform{
+		"OpenDataHub":      cluster.OpenDataHub,
+		"SelfManagedRhods": cluster.SelfManagedRhods,
+		"ManagedRhods":     cluster.ManagedRhods,
+	}
+)
+
+func init() { //nolint:gochecknoinits
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(dsciv1.AddToScheme(scheme))
+	utilruntime.Must(dscv1.AddToScheme(scheme))
+	utilruntime.Must(featurev1.AddToScheme(scheme))
+	utilruntime.Must(apiextensionsv1.AddToScheme(scheme))
+	utilruntime.Must(routev1.Install(scheme))
+	utilruntime.Must(ofapiv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(ofapiv2.AddToScheme(scheme))
+	utilruntime.Must(monitoringv1.AddToScheme(scheme))
+	utilruntime.Must(operatorv1.Install(scheme))
+}
+
+type options struct {
+	dscPath   string
+	dsciPath  string
+	output    string
+	platform  string
+	domain    string
+	operators string
+	logmode   string
+}
+
+func main() {
+	var opts options
+
+	flag.StringVar(&opts.dscPath, "dsc", "", "Path to the DataScienceCluster YAML file.")
+	flag.StringVar(&opts.dsciPath, "dsci", "", "Path to the DSCInitialization YAML file.")
+	flag.StringVar(&opts.output, "output", "", "Path to the file rendered objects are written to, default to standard output.")
+	flag.StringVar(&opts.platform, "platform", "OpenDataHub", "Platform to render manifests for (OpenDataHub, SelfManagedRhods, ManagedRhods).")
+	flag.StringVar(&opts.domain, "domain", "apps.example.com", "Cluster domain used by routes and Service Mesh templates.")
+	flag.StringVar(&opts.operators, "operators", "", "Comma separated list of operator subscriptions installed on the target cluster, "+
+		"e.g. authorino-operator,servicemeshoperator,serverless-operator")
+	flag.StringVar(&opts.logmode, "log-mode", "", "Log mode ('', prod, devel), default to the log mode of the DSCInitialization.")
+
+	flag.Parse()
+
+	if err := run(opts); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(opts options) error {
+	if opts.dscPath == "" || opts.dsciPath == "" {
+		return fmt.Errorf("both --dsc and --dsci are required")
+	}
+
+	platform, found := platforms[opts.platform]
+	if !found {
+		return fmt.Errorf("unknown platform %s", opts.platform)
+	}
+
+	dsc := &dscv1.DataScienceCluster{}
+	if err := readObject(opts.dscPath, dsc); err != nil {
+		return err
+	}
+	dsci := &dsciv1.DSCInitialization{}
+	if err := readObject(opts.dsciPath, dsci); err != nil {
+		return err
+	}
+
+	out := os.Stdout
+	if opts.output != "" {
+		file, err := os.Create(opts.output)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		out = file
+	}
+	// Standard output is kept for the rendered objects, components log to standard error with the log mode
+	// of the DSCInitialization unless it is overridden
+	if dsci.Spec.DevFlags != nil {
+		if opts.logmode == "" {
+			opts.logmode = dsci.Spec.DevFlags.LogMode
+		}
+		dsci.Spec.DevFlags.LogMode = ""
+	}
+	log := logger.NewLogger(opts.logmode, os.Stderr)
+	ctrl.SetLogger(log)
+
+	cli := applyClient{
+		Client: fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(seedObjects(dsc, dsci, opts)...).
+			Build(),
+	}
+
+	renderer := deploy.NewRenderer(cli)
+	ctx := deploy.WithRenderer(context.Background(), renderer)
+
+	renderErr := render(ctx, cli, log, dsc, dsci, platform)
+
+	if err := writeObjects(out, renderer); err != nil {
+		return err
+	}
+
+	return renderErr
+}
+
+// render runs DSCInitialization Service Mesh setup and all components of the DataScienceCluster, in the same order
+// the operator reconciles them, against the fake client. Errors are collected so the output is as complete as possible.
+func render(ctx context.Context, cli client.Client, log logr.Logger,
+	dsc *dscv1.DataScienceCluster, dsci *dsciv1.DSCInitialization, platform cluster.Platform,
+) error {
+	var renderErrors []string
+
+	dsciReconciler := &dscicontr.DSCInitializationReconciler{
+		Client:   cli,
+		Scheme:   scheme,
+		Log:      log.WithName("DSCInitialization"),
+		Recorder: record.NewFakeRecorder(100),
+	}
+	if err := dsciReconciler.ConfigureServiceMesh(ctx, dsci); err != nil {
+		renderErrors = append(renderErrors, fmt.Sprintf("failed rendering service mesh: %v", err))
+	}
+
+	allComponents, err := dsc.GetComponents()
+	if err != nil {
+		return err
+	}
+	levels, err := components.ReconcileOrder(allComponents)
+	if err != nil {
+		return err
+	}
+
+	for _, level := range levels {
+		for _, component := range level {
+			// Components which are not managed do not apply any object
+			if component.GetManagementState() != operatorv1.Managed {
+				continue
+			}
+			componentName := component.GetComponentName()
+			if missing := missingManifests(component); len(missing) != 0 {
+				renderErrors = append(renderErrors, fmt.Sprintf("failed rendering %s: manifests not found in %s", componentName, strings.Join(missing, ", ")))
+
+				continue
+			}
+			componentCtx := deploy.WithPreservedFields(ctx, dsci.Spec.PreservedFields, component.GetPreservedFields())
+			if err := component.ReconcileComponent(componentCtx, cli, log.WithName(componentName), dsc, &dsci.Spec, platform, false); err != nil {
+				renderErrors = append(renderErrors, fmt.Sprintf("failed rendering %s: %v", componentName, err))
+			}
+		}
+	}
+
+	if len(renderErrors) != 0 {
+		return fmt.Errorf("rendering completed with errors:\n%s", strings.Join(renderErrors, "\n"))
+	}
+
+	return nil
+}
+
+// missingManifests returns the folders of the manifests tree a component is deployed from, as registered by the
+// component, which are missing. Components using DevFlags manifests download them instead.
+func missingManifests(component components.ComponentInterface) []string {
+	registration, found := components.Lookup(component.GetComponentName())
+	if !found || component.GetCommon().ManifestsOverride("") != nil {
+		return nil
+	}
+
+	var missing []string
+	for _, folder := range registration.ManifestsFolders {
+		path := filepath.Join(deploy.DefaultManifestPath, folder)
+		if _, err := os.Stat(path); err != nil {
+			missing = append(missing, path)
+		}
+	}
+
+	return missing
+}
+
+// seedObjects returns the objects components expect to find in the cluster when they are reconciled.
+func seedObjects(dsc *dscv1.DataScienceCluster, dsci *dsciv1.DSCInitialization, opts options) []client.Object {
+	appNamespace := dsci.Spec.ApplicationsNamespace
+
+	ingress := &unstructured.Unstructured{}
+	ingress.SetGroupVersionKind(gvk.OpenshiftIngress)
+	ingress.SetName("cluster")
+	utilruntime.Must(unstructured.SetNestedField(ingress.Object, opts.domain, "spec", "domain"))
+
+	objs := []client.Object{
+		dsc,
+		dsci,
+		ingress,
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: appNamespace}},
+		// Created by DSCInitialization, components add their service accounts to it
+		&rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: appNamespace, Namespace: appNamespace}},
+		&routev1.Route{
+			ObjectMeta: metav1.ObjectMeta{Name: dashboard.NameConsoleLink, Namespace: dashboard.NamespaceConsoleLink},
+			Spec:       routev1.RouteSpec{Host: dashboard.NameConsoleLink + "-" + dashboard.NamespaceConsoleLink + "." + opts.domain},
+		},
+	}
+
+	if dsci.Spec.Monitoring.Namespace != "" && dsci.Spec.Monitoring.Namespace != appNamespace {
+		objs = append(objs, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: dsci.Spec.Monitoring.Namespace}})
+	}
+
+	for _, operator := range strings.Split(opts.operators, ",") {
+		operator = strings.TrimSpace(operator)
+		if operator == "" {
+			continue
+		}
+		objs = append(objs,
+			&ofapiv1alpha1.Subscription{ObjectMeta: metav1.ObjectMeta{Name: operator, Namespace: "openshift-operators"}},
+			&ofapiv2.OperatorCondition{ObjectMeta: metav1.ObjectMeta{Name: operator, Namespace: "openshift-operators"}},
+		)
+	}
+
+	return objs
+}
+
+func readObject(path string, obj client.Object) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := yaml.Unmarshal(content, obj); err != nil {
+		return fmt.Errorf("failed parsing %s: %w", path, err)
+	}
+	// Drop server populated fields of objects exported from a cluster, so they can be seeded in the fake client
+	obj.SetResourceVersion("")
+
+	return nil
+}
+
+// writeObjects writes rendered objects as a YAML stream, followed by patches targeting objects not created by the operator.
+func writeObjects(out io.Writer, renderer *deploy.Renderer) error {
+	for _, obj := range renderer.Objects() {
+		if err := writeObject(out, "", obj); err != nil {
+			return err
+		}
+	}
+	for _, patch := range renderer.Patches() {
+		if err := writeObject(out, "# patch applied to an existing object\n", patch); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeObject(out io.Writer, header string, obj *unstructured.Unstructured) error {
+	content, err := yaml.Marshal(obj.Object)
+	if err != nil {
+		return fmt.Errorf("failed converting %s %s to yaml: %w", obj.GetKind(), obj.GetName(), err)
+	}
+	_, err = fmt.Fprintf(out, "---\n%s%s", header, content)
+
+	return err
+}
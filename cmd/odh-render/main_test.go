@@ -0,0 +1,167 @@
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestRender(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "odh-render unit tests")
+}
+
+const dsciYAML = `apiVersion: dscinitialization.opendatahub.io/v1
+kind: DSCInitialization
+metadata:
+  name: default-dsci
+spec:
+  applicationsNamespace: opendatahub
+  monitoring:
+    managementState: Removed
+  serviceMesh:
+    managementState: Removed
+`
+
+var _ = Describe("Rendering a DataScienceCluster", func() {
+
+	var (
+		dir    string
+		server *httptest.Server
+		opts   options
+	)
+
+	writeFile := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		Expect(os.WriteFile(path, []byte(content), 0o600)).To(Succeed())
+
+		return path
+	}
+
+	dscWith := func(trustyai string) string {
+		return writeFile("dsc.yaml", `apiVersion: datasciencecluster.opendatahub.io/v1
+kind: DataScienceCluster
+metadata:
+  name: default-dsc
+spec:
+  components:
+    trustyai:
+`+trustyai)
+	}
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+
+		previousPath := deploy.ManifestsWorkspacePath
+		deploy.ManifestsWorkspacePath = GinkgoT().TempDir()
+		DeferCleanup(func() {
+			deploy.ManifestsWorkspacePath = previousPath
+		})
+
+		archive := manifestsArchive(map[string]string{
+			"repo/manifests/base/kustomization.yaml": "resources:\n- configmap.yaml\n",
+			"repo/manifests/base/configmap.yaml":     "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: trustyai-config\ndata:\n  key: value\n",
+		})
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write(archive)
+		}))
+		DeferCleanup(server.Close)
+
+		opts = options{
+			dsciPath: writeFile("dsci.yaml", dsciYAML),
+			output:   filepath.Join(dir, "rendered.yaml"),
+			platform: "OpenDataHub",
+			domain:   "apps.example.com",
+		}
+	})
+
+	It("should require both the DataScienceCluster and the DSCInitialization", func() {
+		opts.dsciPath = ""
+
+		Expect(run(opts)).To(MatchError(ContainSubstring("both --dsc and --dsci are required")))
+	})
+
+	It("should reject an unknown platform", func() {
+		opts.dscPath = dscWith("      managementState: Removed\n")
+		opts.platform = "Kubernetes"
+
+		Expect(run(opts)).To(MatchError(ContainSubstring("unknown platform Kubernetes")))
+	})
+
+	It("should write the objects of managed components, in their namespace and with their labels", func() {
+		opts.dscPath = dscWith(`      managementState: Managed
+      devFlags:
+        manifests:
+        - uri: ` + server.URL + `
+          contextDir: manifests
+`)
+
+		Expect(run(opts)).To(Succeed())
+
+		objects := readObjects(opts.output)
+		Expect(objects).To(HaveLen(1))
+		Expect(objects[0]).To(HaveKeyWithValue("kind", "ConfigMap"))
+		Expect(objects[0]).To(HaveKeyWithValue("metadata", And(
+			HaveKeyWithValue("name", "trustyai-config"),
+			HaveKeyWithValue("namespace", "opendatahub"),
+			HaveKeyWithValue("labels", HaveKeyWithValue("app.opendatahub.io/trustyai", "true")),
+		)))
+	})
+
+	It("should report managed components whose manifests are missing", func() {
+		opts.dscPath = dscWith("      managementState: Managed\n")
+
+		err := run(opts)
+
+		Expect(err).To(MatchError(ContainSubstring("failed rendering trustyai: manifests not found in " +
+			filepath.Join(deploy.DefaultManifestPath, "trustyai-service-operator"))))
+		Expect(opts.output).To(BeARegularFile())
+	})
+})
+
+func manifestsArchive(files map[string]string) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, content := range files {
+		Expect(tw.WriteHeader(&tar.Header{Name: name, Mode: 0o600, Size: int64(len(content)), Typeflag: tar.TypeReg})).To(Succeed())
+		_, err := tw.Write([]byte(content))
+		Expect(err).ToNot(HaveOccurred())
+	}
+	Expect(tw.Close()).To(Succeed())
+	Expect(gz.Close()).To(Succeed())
+
+	return buf.Bytes()
+}
+
+func readObjects(path string) []map[string]interface{} {
+	content, err := os.ReadFile(path)
+	Expect(err).ToNot(HaveOccurred())
+
+	var objects []map[string]interface{}
+	decoder := yaml.NewYAMLOrJSONDecoder(strings.NewReader(string(content)), 4096)
+	for {
+		obj := map[string]interface{}{}
+		if err := decoder.Decode(&obj); err != nil {
+			break
+		}
+		if len(obj) != 0 {
+			objects = append(objects, obj)
+		}
+	}
+
+	return objects
+}
@@ -6,7 +6,6 @@ package codeflare
 import (
 	"context"
 	"fmt"
-	"path/filepath"
 
 	"github.com/go-logr/logr"
 	operatorv1 "github.com/openshift/api/operator/v1"
@@ -15,42 +14,46 @@ import (
 
 	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/components"
+	"github.com/opendatahub-io/opendatahub-operator/v2/components/ray"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/monitoring"
 )
 
 var (
 	ComponentName     = "codeflare"
-	CodeflarePath     = deploy.DefaultManifestPath + "/" + ComponentName + "/default"
+	CodeflarePath     = "default"
 	CodeflareOperator = "codeflare-operator"
-	ParamsPath        = deploy.DefaultManifestPath + "/" + ComponentName + "/manager"
+	ParamsPath        = "manager"
 )
 
 // Verifies that CodeFlare implements ComponentInterface.
 var _ components.ComponentInterface = (*CodeFlare)(nil)
 
+// registration describes CodeFlare to the operator.
+var registration = components.Registration{
+	Name:             ComponentName,
+	Field:            "codeflare",
+	ManifestsFolders: []string{ComponentName},
+	ImageParams: map[string]string{
+		"codeflare-operator-controller-image": "RELATED_IMAGE_ODH_CODEFLARE_OPERATOR_IMAGE", // no need mcad, embedded in cfo
+	},
+	MonitoringRules: []string{ComponentName},
+}
+
+func init() { //nolint:gochecknoinits
+	components.Register(registration)
+}
+
 // CodeFlare struct holds the configuration for the CodeFlare component.
 // +kubebuilder:object:generate=true
 type CodeFlare struct {
 	components.Component `json:""`
 }
 
-func (c *CodeFlare) OverrideManifests(ctx context.Context, _ string) error {
-	// If devflags are set, update default manifests path
-	if len(c.DevFlags.Manifests) != 0 {
-		manifestConfig := c.DevFlags.Manifests[0]
-		if err := deploy.DownloadManifests(ctx, ComponentName, manifestConfig); err != nil {
-			return err
-		}
-		// If overlay is defined, update paths
-		defaultKustomizePath := "default"
-		if manifestConfig.SourcePath != "" {
-			defaultKustomizePath = manifestConfig.SourcePath
-		}
-		CodeflarePath = filepath.Join(deploy.DefaultManifestPath, ComponentName, defaultKustomizePath)
-	}
-
-	return nil
+// GetDependencies makes sure Ray is reconciled first, as CodeFlare manages RayClusters.
+func (c *CodeFlare) GetDependencies() []string {
+	return []string{ray.ComponentName}
 }
 
 func (c *CodeFlare) GetComponentName() string {
@@ -65,21 +68,23 @@ func (c *CodeFlare) ReconcileComponent(ctx context.Context,
 	platform cluster.Platform,
 	_ bool) error {
 	l := c.ConfigComponentLogger(logger, ComponentName, dscispec)
-	var imageParamMap = map[string]string{
-		"codeflare-operator-controller-image": "RELATED_IMAGE_ODH_CODEFLARE_OPERATOR_IMAGE", // no need mcad, embedded in cfo
-		"namespace":                           dscispec.ApplicationsNamespace,
+	imageParamMap := registration.ImageParamsWith(map[string]string{"namespace": dscispec.ApplicationsNamespace})
+
+	workspace, err := deploy.NewWorkspace(ComponentName)
+	if err != nil {
+		return err
+	}
+	defer workspace.Close()
+	// Download manifests set in devflags into the workspace
+	manifestsPath, err := workspace.Resolve(ctx, cli, ComponentName, CodeflarePath, c.ManifestsOverride(""))
+	if err != nil {
+		return err
 	}
 
 	enabled := c.GetManagementState() == operatorv1.Managed
 	monitoringEnabled := dscispec.Monitoring.ManagementState == operatorv1.Managed
 
 	if enabled {
-		if c.DevFlags != nil {
-			// Download manifests and update paths
-			if err := c.OverrideManifests(ctx, string(platform)); err != nil {
-				return err
-			}
-		}
 		// check if the CodeFlare operator is installed: it should not be installed
 		// Both ODH and RHOAI should have the same operator name
 		dependentOperator := CodeflareOperator
@@ -93,15 +98,15 @@ func (c *CodeFlare) ReconcileComponent(ctx context.Context,
 
 		// Update image parameters only when we do not have customized manifests set
 		if (dscispec.DevFlags == nil || dscispec.DevFlags.ManifestsUri == "") && (c.DevFlags == nil || len(c.DevFlags.Manifests) == 0) {
-			if err := deploy.ApplyParams(ParamsPath, imageParamMap, true); err != nil {
-				return fmt.Errorf("failed update image from %s : %w", CodeflarePath+"/bases", err)
+			if err := deploy.ApplyParams(workspace.Path(ComponentName, ParamsPath), imageParamMap, true); err != nil {
+				return fmt.Errorf("failed update image from %s : %w", manifestsPath+"/bases", err)
 			}
 		}
 	}
 
 	// Deploy Codeflare
 	if err := deploy.DeployManifestsFromPath(ctx, cli, owner, //nolint:revive,nolintlint
-		CodeflarePath,
+		manifestsPath,
 		dscispec.ApplicationsNamespace,
 		ComponentName, enabled); err != nil {
 		return err
@@ -111,20 +116,14 @@ func (c *CodeFlare) ReconcileComponent(ctx context.Context,
 	if platform == cluster.ManagedRhods {
 		if enabled {
 			// first check if the service is up, so prometheus won't fire alerts when it is just startup
-			if err := cluster.WaitForDeploymentAvailable(ctx, cli, ComponentName, dscispec.ApplicationsNamespace, 20, 2); err != nil {
+			if err := cluster.CheckDeploymentAvailable(ctx, cli, ComponentName, dscispec.ApplicationsNamespace); err != nil {
 				return fmt.Errorf("deployment for %s is not ready to server: %w", ComponentName, err)
 			}
 			l.Info("deployment is done, updating monitoring rules")
 		}
 
-		// inject prometheus codeflare*.rules in to /opt/manifests/monitoring/prometheus/prometheus-configs.yaml
-		if err := c.UpdatePrometheusConfig(cli, enabled && monitoringEnabled, ComponentName); err != nil {
-			return err
-		}
-		if err := deploy.DeployManifestsFromPath(ctx, cli, owner,
-			filepath.Join(deploy.DefaultManifestPath, "monitoring", "prometheus", "apps"),
-			dscispec.Monitoring.Namespace,
-			"prometheus", true); err != nil {
+		// inject prometheus codeflare*.rules in to the deployed prometheus-configs.yaml
+		if err := monitoring.UpdatePrometheusConfig(ctx, cli, owner, ComponentName, enabled && monitoringEnabled); err != nil {
 			return err
 		}
 		l.Info("updating SRE monitoring done")
@@ -3,18 +3,16 @@ package components
 
 import (
 	"context"
-	"fmt"
-	"os"
-	"path/filepath"
 	"strings"
 
 	"github.com/go-logr/logr"
 	operatorv1 "github.com/openshift/api/operator/v1"
-	"gopkg.in/yaml.v2"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	ctrlogger "github.com/opendatahub-io/opendatahub-operator/v2/pkg/logger"
 )
@@ -38,12 +36,61 @@ type Component struct {
 	// +optional
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=2
 	DevFlags *DevFlags `json:"devFlags,omitempty"`
+
+	// Fields of the component objects which the operator does not overwrite once the objects exist,
+	// in addition to the ones listed in DSCInitialization.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=3
+	PreservedFields []infrav1.FieldPreservationPolicy `json:"preservedFields,omitempty"`
+}
+
+// PreservedContainerResources keeps resources of Deployment containers, which are commonly tuned on the cluster.
+var PreservedContainerResources = infrav1.FieldPreservationPolicy{
+	Group: "apps",
+	Kind:  "Deployment",
+	Paths: []string{".spec.template.spec.containers[*].resources"},
+}
+
+// GetCommon returns the fields common to all components.
+func (c *Component) GetCommon() *Component {
+	return c
 }
 
 func (c *Component) GetManagementState() operatorv1.ManagementState {
 	return c.ManagementState
 }
 
+func (c *Component) GetDevFlags() *DevFlags {
+	return c.DevFlags
+}
+
+// ManifestsOverride returns the DevFlags manifests config whose URI contains the given name, or the first one when name is empty.
+// It returns nil when the manifests are not overridden, or when the component is not managed.
+func (c *Component) ManifestsOverride(name string) *ManifestsConfig {
+	if c.ManagementState != operatorv1.Managed || c.DevFlags == nil {
+		return nil
+	}
+	for i := range c.DevFlags.Manifests {
+		if name == "" || strings.Contains(c.DevFlags.Manifests[i].URI, name) {
+			return &c.DevFlags.Manifests[i]
+		}
+	}
+
+	return nil
+}
+
+// GetPreservedFields returns the fields of the component objects which the operator does not overwrite.
+// Components preserving fields by default override it.
+func (c *Component) GetPreservedFields() []infrav1.FieldPreservationPolicy {
+	return c.PreservedFields
+}
+
+// GetDependencies returns names of the components which have to be reconciled before this one.
+// Components without dependencies rely on this default.
+func (c *Component) GetDependencies() []string {
+	return nil
+}
+
 func (c *Component) Cleanup(_ context.Context, _ client.Client, _ *dsciv1.DSCInitializationSpec) error {
 	// noop
 	return nil
@@ -60,6 +107,9 @@ type DevFlags struct {
 
 type ManifestsConfig struct {
 	// uri is the URI point to a git repo with tag/branch. e.g.  https://github.com/org/repo/tarball/<tag/branch>
+	// It can also point to an OCI artifact holding a tarball, e.g. oci://quay.io/org/manifests:<tag>, or to a directory or tarball
+	// mounted under /mnt/manifests in the operator container, e.g. file:///mnt/manifests/dashboard, or to a ConfigMap holding the files of a kustomization,
+	// e.g. configmap://opendatahub/manifests, in which case contextDir is not used.
 	// +optional
 	// +kubebuilder:default:=""
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=1
@@ -76,6 +126,13 @@ type ManifestsConfig struct {
 	// +kubebuilder:default:=""
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=3
 	SourcePath string `json:"sourcePath,omitempty"`
+
+	// sha256 is the expected SHA-256 digest of the archive downloaded from uri, in hexadecimal. When set, the archive is verified
+	// before manifests are extracted from it, and it is downloaded only once.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[a-fA-F0-9]{64}$`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=4
+	SHA256 string `json:"sha256,omitempty"`
 }
 
 type ComponentInterface interface {
@@ -83,119 +140,25 @@ type ComponentInterface interface {
 		owner metav1.Object, DSCISpec *dsciv1.DSCInitializationSpec, platform cluster.Platform, currentComponentStatus bool) error
 	Cleanup(ctx context.Context, cli client.Client, DSCISpec *dsciv1.DSCInitializationSpec) error
 	GetComponentName() string
+	GetCommon() *Component
 	GetManagementState() operatorv1.ManagementState
-	OverrideManifests(ctx context.Context, platform string) error
-	UpdatePrometheusConfig(cli client.Client, enable bool, component string) error
+	GetDevFlags() *DevFlags
+	GetPreservedFields() []infrav1.FieldPreservationPolicy
+	GetDependencies() []string
 	ConfigComponentLogger(logger logr.Logger, component string, dscispec *dsciv1.DSCInitializationSpec) logr.Logger
 }
 
+// WorkloadsSelector is implemented by components whose readiness does not depend on all the Deployments and StatefulSets
+// deployed from their manifests.
+type WorkloadsSelector interface {
+	// ReadinessWorkloads returns the workloads making the component ready, given the ones deployed from its manifests.
+	ReadinessWorkloads(deployed []corev1.ObjectReference, dscispec *dsciv1.DSCInitializationSpec) []corev1.ObjectReference
+}
+
 // extend origal ConfigLoggers to include component name.
 func (c *Component) ConfigComponentLogger(logger logr.Logger, component string, dscispec *dsciv1.DSCInitializationSpec) logr.Logger {
-	if dscispec.DevFlags != nil {
+	if dscispec.DevFlags != nil && dscispec.DevFlags.LogMode != "" {
 		return ctrlogger.ConfigLoggers(dscispec.DevFlags.LogMode).WithName("DSC.Components." + component)
 	}
 	return logger.WithName("DSC.Components." + component)
 }
-
-// UpdatePrometheusConfig update prometheus-configs.yaml to include/exclude <component>.rules
-// parameter enable when set to true to add new rules, when set to false to remove existing rules.
-func (c *Component) UpdatePrometheusConfig(_ client.Client, enable bool, component string) error {
-	prometheusconfigPath := filepath.Join("/opt/manifests", "monitoring", "prometheus", "apps", "prometheus-configs.yaml")
-
-	// create a struct to mock poremtheus.yml
-	type ConfigMap struct {
-		APIVersion string `yaml:"apiVersion"`
-		Kind       string `yaml:"kind"`
-		Metadata   struct {
-			Name      string `yaml:"name"`
-			Namespace string `yaml:"namespace"`
-		} `yaml:"metadata"`
-		Data struct {
-			PrometheusYML          string `yaml:"prometheus.yml"`
-			OperatorRules          string `yaml:"operator-recording.rules"`
-			DeadManSnitchRules     string `yaml:"deadmanssnitch-alerting.rules"`
-			CFRRules               string `yaml:"codeflare-recording.rules"`
-			CRARules               string `yaml:"codeflare-alerting.rules"`
-			DashboardRRules        string `yaml:"rhods-dashboard-recording.rules"`
-			DashboardARules        string `yaml:"rhods-dashboard-alerting.rules"`
-			DSPRRules              string `yaml:"data-science-pipelines-operator-recording.rules"`
-			DSPARules              string `yaml:"data-science-pipelines-operator-alerting.rules"`
-			MMRRules               string `yaml:"model-mesh-recording.rules"`
-			MMARules               string `yaml:"model-mesh-alerting.rules"`
-			OdhModelRRules         string `yaml:"odh-model-controller-recording.rules"`
-			OdhModelARules         string `yaml:"odh-model-controller-alerting.rules"`
-			RayARules              string `yaml:"ray-alerting.rules"`
-			WorkbenchesRRules      string `yaml:"workbenches-recording.rules"`
-			WorkbenchesARules      string `yaml:"workbenches-alerting.rules"`
-			KserveRRules           string `yaml:"kserve-recording.rules"`
-			KserveARules           string `yaml:"kserve-alerting.rules"`
-			TrustyAIRRules         string `yaml:"trustyai-recording.rules"`
-			TrustyAIARules         string `yaml:"trustyai-alerting.rules"`
-			KueueRRules            string `yaml:"kueue-recording.rules"`
-			KueueARules            string `yaml:"kueue-alerting.rules"`
-			TrainingOperatorRRules string `yaml:"trainingoperator-recording.rules"`
-			TrainingOperatorARules string `yaml:"trainingoperator-alerting.rules"`
-		} `yaml:"data"`
-	}
-	var configMap ConfigMap
-	// prometheusContent will represent content of prometheus.yml due to its dynamic struct
-	var prometheusContent map[interface{}]interface{}
-
-	// read prometheus.yml from local disk /opt/mainfests/monitoring/prometheus/apps/
-	yamlData, err := os.ReadFile(prometheusconfigPath)
-	if err != nil {
-		return err
-	}
-	if err := yaml.Unmarshal(yamlData, &configMap); err != nil {
-		return err
-	}
-
-	// get prometheus.yml part from configmap
-	if err := yaml.Unmarshal([]byte(configMap.Data.PrometheusYML), &prometheusContent); err != nil {
-		return err
-	}
-
-	// to add component rules when it is not there yet
-	if enable {
-		// Check if the rule not yet exists in rule_files
-		if !strings.Contains(configMap.Data.PrometheusYML, component+"*.rules") {
-			// check if have rule_files
-			if ruleFiles, ok := prometheusContent["rule_files"]; ok {
-				if ruleList, isList := ruleFiles.([]interface{}); isList {
-					// add new component rules back to rule_files
-					ruleList = append(ruleList, component+"*.rules")
-					prometheusContent["rule_files"] = ruleList
-				}
-			}
-		}
-	} else { // to remove component rules if it is there
-		fmt.Println("Removing prometheus rule: " + component + "*.rules")
-		if ruleList, ok := prometheusContent["rule_files"].([]interface{}); ok {
-			for i, item := range ruleList {
-				if rule, isStr := item.(string); isStr && rule == component+"*.rules" {
-					ruleList = append(ruleList[:i], ruleList[i+1:]...)
-
-					break
-				}
-			}
-			prometheusContent["rule_files"] = ruleList
-		}
-	}
-
-	// Marshal back
-	newDataYAML, err := yaml.Marshal(&prometheusContent)
-	if err != nil {
-		return err
-	}
-	configMap.Data.PrometheusYML = string(newDataYAML)
-
-	newyamlData, err := yaml.Marshal(&configMap)
-	if err != nil {
-		return err
-	}
-
-	// Write the modified content back to the file
-	err = os.WriteFile(prometheusconfigPath, newyamlData, 0)
-
-	return err
-}
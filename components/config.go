@@ -0,0 +1,64 @@
+package components
+
+import (
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Config holds the configuration of the workloads of a component, common to all components. It is set with the v2 API
+// of DataScienceCluster, and applied to the Deployments deployed for the component.
+// +kubebuilder:object:generate=true
+type Config struct {
+	// Replicas of the Deployments of the component.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// Resources of the main container of the Deployments of the component, which is the one named by the
+	// kubectl.kubernetes.io/default-container annotation of their pods, or the first one. Sidecars keep their
+	// resources. They take precedence over resources preserved on the cluster.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// NodePlacement constrains the nodes the pods of the component are scheduled on.
+	// +optional
+	NodePlacement *NodePlacement `json:"nodePlacement,omitempty"`
+
+	// ImageOverrides replace the images of containers of the component set in its manifests.
+	// +optional
+	ImageOverrides []ImageOverride `json:"imageOverrides,omitempty"`
+
+	// LogLevel is the log mode of the operator while reconciling the component, unless the
+	// DSCInitialization devFlags set one.
+	// +kubebuilder:validation:Enum=devel;development;prod;production
+	// +optional
+	LogLevel string `json:"logLevel,omitempty"`
+}
+
+// NodePlacement describes the nodes pods are scheduled on.
+// +kubebuilder:object:generate=true
+type NodePlacement struct {
+	// NodeSelector replaces the node selector of the pods.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Tolerations replace the tolerations of the pods.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+}
+
+// ImageOverride replaces the image of a container.
+// +kubebuilder:object:generate=true
+type ImageOverride struct {
+	// Container is the name of the container, in any of the Deployments of the component.
+	Container string `json:"container"`
+
+	// Image replacing the one set in the manifests.
+	Image string `json:"image"`
+}
+
+// IsEmpty returns true when the config does not change the workloads of the component.
+func (c *Config) IsEmpty() bool {
+	return c == nil || reflect.DeepEqual(*c, Config{})
+}
@@ -22,21 +22,28 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/common"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/monitoring"
 )
 
+// +kubebuilder:rbac:groups="opendatahub.io",resources=odhdashboardconfigs,verbs=create;get;patch;watch;update;delete;list
+// +kubebuilder:rbac:groups="console.openshift.io",resources=odhquickstarts,verbs=create;get;patch;list;delete
+// +kubebuilder:rbac:groups="dashboard.opendatahub.io",resources=odhdocuments,verbs=create;get;patch;list;delete
+// +kubebuilder:rbac:groups="dashboard.opendatahub.io",resources=odhapplications,verbs=create;get;patch;list;delete
+// +kubebuilder:rbac:groups="dashboard.opendatahub.io",resources=acceleratorprofiles,verbs=create;get;patch;list;delete
+
 var (
 	ComponentName   = "dashboard"
-	Path            = deploy.DefaultManifestPath + "/" + ComponentName + "/base"        // ODH
-	PathISV         = deploy.DefaultManifestPath + "/" + ComponentName + "/apps"        // ODH APPS
-	PathCRDs        = deploy.DefaultManifestPath + "/" + ComponentName + "/crd"         // ODH + RHOAI
-	PathConsoleLink = deploy.DefaultManifestPath + "/" + ComponentName + "/consolelink" // ODH consolelink
+	Path            = "base"        // ODH
+	PathISV         = "apps"        // ODH APPS
+	PathCRDs        = "crd"         // ODH + RHOAI
+	PathConsoleLink = "consolelink" // ODH consolelink
 
 	ComponentNameSupported   = "rhods-dashboard"
-	PathSupported            = deploy.DefaultManifestPath + "/" + ComponentName + "/overlays/rhoai"              // RHOAI
-	PathISVSM                = deploy.DefaultManifestPath + "/" + ComponentName + "/overlays/apps/apps-onprem"   // RHOAI APPS
-	PathISVAddOn             = deploy.DefaultManifestPath + "/" + ComponentName + "/overlays/apps/apps-addon"    // RHOAI APPS
-	PathConsoleLinkSupported = deploy.DefaultManifestPath + "/" + ComponentName + "/overlays/consolelink"        // RHOAI
-	PathODHDashboardConfig   = deploy.DefaultManifestPath + "/" + ComponentName + "/overlays/odhdashboardconfig" // RHOAI odhdashboardconfig
+	PathSupported            = "overlays/rhoai"              // RHOAI
+	PathISVSM                = "overlays/apps/apps-onprem"   // RHOAI APPS
+	PathISVAddOn             = "overlays/apps/apps-addon"    // RHOAI APPS
+	PathConsoleLinkSupported = "overlays/consolelink"        // RHOAI
+	PathODHDashboardConfig   = "overlays/odhdashboardconfig" // RHOAI odhdashboardconfig
 
 	NameConsoleLink      = "console"
 	NamespaceConsoleLink = "openshift-console"
@@ -45,36 +52,64 @@ var (
 // Verifies that Dashboard implements ComponentInterface.
 var _ components.ComponentInterface = (*Dashboard)(nil)
 
+// registration describes the Dashboard to the operator.
+var registration = components.Registration{
+	Name:             ComponentName,
+	Field:            "dashboard",
+	ManifestsFolders: []string{ComponentName},
+	ImageParams: map[string]string{
+		"odh-dashboard-image": "RELATED_IMAGE_ODH_DASHBOARD_IMAGE",
+	},
+	MonitoringRules: []string{ComponentNameSupported},
+}
+
+func init() { //nolint:gochecknoinits
+	components.Register(registration)
+}
+
 // Dashboard struct holds the configuration for the Dashboard component.
 // +kubebuilder:object:generate=true
 type Dashboard struct {
 	components.Component `json:""`
 }
 
-func (d *Dashboard) OverrideManifests(ctx context.Context, platform string) error {
-	// If devflags are set, update default manifests path
-	if len(d.DevFlags.Manifests) != 0 {
-		manifestConfig := d.DevFlags.Manifests[0]
-		if err := deploy.DownloadManifests(ctx, ComponentName, manifestConfig); err != nil {
-			return err
-		}
-		// If overlay is defined, update paths
-		if platform == string(cluster.ManagedRhods) || platform == string(cluster.SelfManagedRhods) {
-			defaultKustomizePath := "overlays/rhoai"
-			if manifestConfig.SourcePath != "" {
-				defaultKustomizePath = manifestConfig.SourcePath
-			}
-			PathSupported = filepath.Join(deploy.DefaultManifestPath, ComponentName, defaultKustomizePath)
-		} else {
-			defaultKustomizePath := "base"
-			if manifestConfig.SourcePath != "" {
-				defaultKustomizePath = manifestConfig.SourcePath
-			}
-			Path = filepath.Join(deploy.DefaultManifestPath, ComponentName, defaultKustomizePath)
-		}
+// manifestsPaths are the paths of the manifests to render, resolved in the workspace of a reconcile.
+type manifestsPaths struct {
+	path                     string
+	pathISV                  string
+	pathCRDs                 string
+	pathConsoleLink          string
+	pathSupported            string
+	pathISVSM                string
+	pathISVAddOn             string
+	pathConsoleLinkSupported string
+	pathODHDashboardConfig   string
+}
+
+// resolveManifests downloads manifests set in devflags into the workspace, in place of the built-in ones.
+// Their sourcePath overrides the overlay of the platform.
+func (d *Dashboard) resolveManifests(ctx context.Context, cli client.Client, workspace *deploy.Workspace,
+	platform cluster.Platform,
+) (manifestsPaths, error) {
+	paths := manifestsPaths{
+		path:                     workspace.Path(ComponentName, Path),
+		pathISV:                  workspace.Path(ComponentName, PathISV),
+		pathCRDs:                 workspace.Path(ComponentName, PathCRDs),
+		pathConsoleLink:          workspace.Path(ComponentName, PathConsoleLink),
+		pathSupported:            workspace.Path(ComponentName, PathSupported),
+		pathISVSM:                workspace.Path(ComponentName, PathISVSM),
+		pathISVAddOn:             workspace.Path(ComponentName, PathISVAddOn),
+		pathConsoleLinkSupported: workspace.Path(ComponentName, PathConsoleLinkSupported),
+		pathODHDashboardConfig:   workspace.Path(ComponentName, PathODHDashboardConfig),
+	}
+	var err error
+	if platform == cluster.ManagedRhods || platform == cluster.SelfManagedRhods {
+		paths.pathSupported, err = workspace.Resolve(ctx, cli, ComponentName, PathSupported, d.ManifestsOverride(""))
+	} else {
+		paths.path, err = workspace.Resolve(ctx, cli, ComponentName, Path, d.ManifestsOverride(""))
 	}
 
-	return nil
+	return paths, err
 }
 
 func (d *Dashboard) GetComponentName() string {
@@ -98,9 +133,17 @@ func (d *Dashboard) ReconcileComponent(ctx context.Context,
 		l = d.ConfigComponentLogger(logger, ComponentName, dscispec)
 	}
 
-	var imageParamMap = map[string]string{
-		"odh-dashboard-image": "RELATED_IMAGE_ODH_DASHBOARD_IMAGE",
+	imageParamMap := registration.ImageParams
+	workspace, err := deploy.NewWorkspace(ComponentName)
+	if err != nil {
+		return err
+	}
+	defer workspace.Close()
+	paths, err := d.resolveManifests(ctx, cli, workspace, platform)
+	if err != nil {
+		return err
 	}
+
 	enabled := d.GetManagementState() == operatorv1.Managed
 	monitoringEnabled := dscispec.Monitoring.ManagementState == operatorv1.Managed
 
@@ -111,14 +154,8 @@ func (d *Dashboard) ReconcileComponent(ctx context.Context,
 		if err := d.cleanOauthClient(ctx, cli, dscispec, currentComponentExist, l); err != nil {
 			return err
 		}
-		if d.DevFlags != nil {
-			// Download manifests and update paths
-			if err := d.OverrideManifests(ctx, string(platform)); err != nil {
-				return err
-			}
-		}
 		// 1. Deploy CRDs
-		if err := d.deployCRDsForPlatform(ctx, cli, owner, dscispec.ApplicationsNamespace, platform); err != nil {
+		if err := d.deployCRDsForPlatform(ctx, cli, owner, paths, dscispec.ApplicationsNamespace, platform); err != nil {
 			return fmt.Errorf("failed to deploy Dashboard CRD: %w", err)
 		}
 
@@ -139,8 +176,8 @@ func (d *Dashboard) ReconcileComponent(ctx context.Context,
 
 		// 3. Update image parameters
 		if (dscispec.DevFlags == nil || dscispec.DevFlags.ManifestsUri == "") && (d.DevFlags == nil || len(d.DevFlags.Manifests) == 0) {
-			if err := deploy.ApplyParams(PathSupported, imageParamMap, false); err != nil {
-				return fmt.Errorf("failed to update image from %s : %w", PathSupported, err)
+			if err := deploy.ApplyParams(paths.pathSupported, imageParamMap, false); err != nil {
+				return fmt.Errorf("failed to update image from %s : %w", paths.pathSupported, err)
 			}
 		}
 	}
@@ -154,16 +191,16 @@ func (d *Dashboard) ReconcileComponent(ctx context.Context,
 			return fmt.Errorf("failed to create access-secret for anaconda: %w", err)
 		}
 		// overlay which including ../../base + anaconda-ce-validator
-		if err := deploy.DeployManifestsFromPath(ctx, cli, owner, PathSupported, dscispec.ApplicationsNamespace, ComponentNameSupported, enabled); err != nil {
-			return fmt.Errorf("failed to apply manifests from %s: %w", PathSupported, err)
+		if err := deploy.DeployManifestsFromPath(ctx, cli, owner, paths.pathSupported, dscispec.ApplicationsNamespace, ComponentNameSupported, enabled); err != nil {
+			return fmt.Errorf("failed to apply manifests from %s: %w", paths.pathSupported, err)
 		}
 
 		// Apply RHOAI specific configs, e.g anaconda screct and cronjob and ISV
-		if err := d.applyRHOAISpecificConfigs(ctx, cli, owner, dscispec.ApplicationsNamespace, platform); err != nil {
+		if err := d.applyRHOAISpecificConfigs(ctx, cli, owner, paths, dscispec.ApplicationsNamespace, platform); err != nil {
 			return err
 		}
 		// consolelink
-		if err := d.deployConsoleLink(ctx, cli, owner, platform, dscispec.ApplicationsNamespace, ComponentNameSupported); err != nil {
+		if err := d.deployConsoleLink(ctx, cli, owner, paths, platform, dscispec.ApplicationsNamespace, ComponentNameSupported); err != nil {
 			return err
 		}
 		l.Info("apply manifests done")
@@ -172,19 +209,13 @@ func (d *Dashboard) ReconcileComponent(ctx context.Context,
 		if platform == cluster.ManagedRhods {
 			if enabled {
 				// first check if the service is up, so prometheus won't fire alerts when it is just startup
-				if err := cluster.WaitForDeploymentAvailable(ctx, cli, ComponentNameSupported, dscispec.ApplicationsNamespace, 20, 3); err != nil {
+				if err := cluster.CheckDeploymentAvailable(ctx, cli, ComponentNameSupported, dscispec.ApplicationsNamespace); err != nil {
 					return fmt.Errorf("deployment for %s is not ready to server: %w", ComponentName, err)
 				}
 				l.Info("deployment is done, updating monitoring rules")
 			}
 
-			if err := d.UpdatePrometheusConfig(cli, enabled && monitoringEnabled, ComponentNameSupported); err != nil {
-				return err
-			}
-			if err := deploy.DeployManifestsFromPath(ctx, cli, owner,
-				filepath.Join(deploy.DefaultManifestPath, "monitoring", "prometheus", "apps"),
-				dscispec.Monitoring.Namespace,
-				"prometheus", true); err != nil {
+			if err := monitoring.UpdatePrometheusConfig(ctx, cli, owner, ComponentName, enabled && monitoringEnabled); err != nil {
 				return err
 			}
 			l.Info("updating SRE monitoring done")
@@ -192,15 +223,15 @@ func (d *Dashboard) ReconcileComponent(ctx context.Context,
 		return nil
 	default:
 		// base
-		if err := deploy.DeployManifestsFromPath(ctx, cli, owner, Path, dscispec.ApplicationsNamespace, ComponentName, enabled); err != nil {
+		if err := deploy.DeployManifestsFromPath(ctx, cli, owner, paths.path, dscispec.ApplicationsNamespace, ComponentName, enabled); err != nil {
 			return err
 		}
 		// ISV
-		if err := deploy.DeployManifestsFromPath(ctx, cli, owner, PathISV, dscispec.ApplicationsNamespace, ComponentName, enabled); err != nil {
+		if err := deploy.DeployManifestsFromPath(ctx, cli, owner, paths.pathISV, dscispec.ApplicationsNamespace, ComponentName, enabled); err != nil {
 			return err
 		}
 		// consolelink
-		if err := d.deployConsoleLink(ctx, cli, owner, platform, dscispec.ApplicationsNamespace, ComponentName); err != nil {
+		if err := d.deployConsoleLink(ctx, cli, owner, paths, platform, dscispec.ApplicationsNamespace, ComponentName); err != nil {
 			return err
 		}
 		l.Info("apply manifests done")
@@ -208,20 +239,20 @@ func (d *Dashboard) ReconcileComponent(ctx context.Context,
 	}
 }
 
-func (d *Dashboard) deployCRDsForPlatform(ctx context.Context, cli client.Client, owner metav1.Object, namespace string, platform cluster.Platform) error {
+func (d *Dashboard) deployCRDsForPlatform(ctx context.Context, cli client.Client, owner metav1.Object, paths manifestsPaths, namespace string, platform cluster.Platform) error {
 	componentName := ComponentName
 	if platform == cluster.SelfManagedRhods || platform == cluster.ManagedRhods {
 		componentName = ComponentNameSupported
 	}
 	// we only deploy CRD, we do not remove CRD
-	return deploy.DeployManifestsFromPath(ctx, cli, owner, PathCRDs, namespace, componentName, true)
+	return deploy.DeployManifestsFromPath(ctx, cli, owner, paths.pathCRDs, namespace, componentName, true)
 }
 
-func (d *Dashboard) applyRHOAISpecificConfigs(ctx context.Context, cli client.Client, owner metav1.Object, namespace string, platform cluster.Platform) error {
+func (d *Dashboard) applyRHOAISpecificConfigs(ctx context.Context, cli client.Client, owner metav1.Object, paths manifestsPaths, namespace string, platform cluster.Platform) error {
 	enabled := d.ManagementState == operatorv1.Managed
 
 	// set proper group name
-	dashboardConfig := filepath.Join(PathODHDashboardConfig, "odhdashboardconfig.yaml")
+	dashboardConfig := filepath.Join(paths.pathODHDashboardConfig, "odhdashboardconfig.yaml")
 	adminGroups := map[cluster.Platform]string{
 		cluster.SelfManagedRhods: "rhods-admins",
 		cluster.ManagedRhods:     "dedicated-admins",
@@ -230,34 +261,34 @@ func (d *Dashboard) applyRHOAISpecificConfigs(ctx context.Context, cli client.Cl
 	if err := common.ReplaceStringsInFile(dashboardConfig, map[string]string{"<admin_groups>": adminGroups}); err != nil {
 		return err
 	}
-	if err := deploy.DeployManifestsFromPath(ctx, cli, owner, PathODHDashboardConfig, namespace, ComponentNameSupported, enabled); err != nil {
-		return fmt.Errorf("failed to create OdhDashboardConfig from %s: %w", PathODHDashboardConfig, err)
+	if err := deploy.DeployManifestsFromPath(ctx, cli, owner, paths.pathODHDashboardConfig, namespace, ComponentNameSupported, enabled); err != nil {
+		return fmt.Errorf("failed to create OdhDashboardConfig from %s: %w", paths.pathODHDashboardConfig, err)
 	}
 	// ISV
-	path := PathISVSM
+	path := paths.pathISVSM
 	if platform == cluster.ManagedRhods {
-		path = PathISVAddOn
+		path = paths.pathISVAddOn
 	}
 	if err := deploy.DeployManifestsFromPath(ctx, cli, owner, path, namespace, ComponentNameSupported, enabled); err != nil {
-		return fmt.Errorf("failed to set dashboard ISV from %s : %w", Path, err)
+		return fmt.Errorf("failed to set dashboard ISV from %s : %w", paths.path, err)
 	}
 	return nil
 }
 
-func (d *Dashboard) deployConsoleLink(ctx context.Context, cli client.Client, owner metav1.Object, platform cluster.Platform, namespace, componentName string) error {
+func (d *Dashboard) deployConsoleLink(ctx context.Context, cli client.Client, owner metav1.Object, paths manifestsPaths, platform cluster.Platform, namespace, componentName string) error {
 	var manifestsPath, sectionTitle, routeName string
 	switch platform {
 	case cluster.SelfManagedRhods:
 		sectionTitle = "OpenShift Self Managed Services"
-		manifestsPath = PathConsoleLinkSupported
+		manifestsPath = paths.pathConsoleLinkSupported
 		routeName = componentName
 	case cluster.ManagedRhods:
 		sectionTitle = "OpenShift Managed Services"
-		manifestsPath = PathConsoleLinkSupported
+		manifestsPath = paths.pathConsoleLinkSupported
 		routeName = componentName
 	default:
 		sectionTitle = "OpenShift Open Data Hub"
-		manifestsPath = PathConsoleLink
+		manifestsPath = paths.pathConsoleLink
 		routeName = "odh-dashboard"
 	}
 
@@ -278,7 +309,7 @@ func (d *Dashboard) deployConsoleLink(ctx context.Context, cli client.Client, ow
 	}
 
 	enabled := d.ManagementState == operatorv1.Managed
-	if err := deploy.DeployManifestsFromPath(ctx, cli, owner, PathConsoleLink, namespace, componentName, enabled); err != nil {
+	if err := deploy.DeployManifestsFromPath(ctx, cli, owner, paths.pathConsoleLink, namespace, componentName, enabled); err != nil {
 		return fmt.Errorf("failed to set dashboard consolelink %s : %w", pathConsoleLink, err)
 	}
 
@@ -291,7 +322,7 @@ func (d *Dashboard) cleanOauthClient(ctx context.Context, cli client.Client, dsc
 	// Assumption: Component is currently set to enabled
 	name := "dashboard-oauth-client"
 	if !currentComponentExist {
-		fmt.Println("Cleanup any left secret")
+		l.Info("Cleanup any left secret")
 		// Delete client secrets from previous installation
 		oauthClientSecret := &corev1.Secret{}
 		err := cli.Get(ctx, client.ObjectKey{
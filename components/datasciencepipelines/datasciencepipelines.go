@@ -6,7 +6,6 @@ package datasciencepipelines
 import (
 	"context"
 	"fmt"
-	"path/filepath"
 
 	"github.com/go-logr/logr"
 	operatorv1 "github.com/openshift/api/operator/v1"
@@ -23,42 +22,64 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/monitoring"
 )
 
+//+kubebuilder:rbac:groups="image.openshift.io",resources=imagestreamtags,verbs=get
+//+kubebuilder:rbac:groups="authentication.k8s.io",resources=tokenreviews,verbs=create;get
+//+kubebuilder:rbac:groups="authorization.k8s.io",resources=subjectaccessreviews,verbs=create;get
+
+//+kubebuilder:rbac:groups="datasciencepipelinesapplications.opendatahub.io",resources=datasciencepipelinesapplications/status,verbs=update;patch;get
+//+kubebuilder:rbac:groups="datasciencepipelinesapplications.opendatahub.io",resources=datasciencepipelinesapplications/finalizers,verbs=update;patch;get
+//+kubebuilder:rbac:groups="datasciencepipelinesapplications.opendatahub.io",resources=datasciencepipelinesapplications,verbs=create;delete;list;update;watch;patch;get
+//+kubebuilder:rbac:groups="argoproj.io",resources=workflows,verbs=*
+
 var (
 	ComponentName   = "data-science-pipelines-operator"
-	Path            = deploy.DefaultManifestPath + "/" + ComponentName + "/base"
-	OverlayPath     = deploy.DefaultManifestPath + "/" + ComponentName + "/overlays"
+	Path            = "base"
+	OverlayPath     = "overlays"
 	ArgoWorkflowCRD = "workflows.argoproj.io"
 )
 
 // Verifies that Dashboard implements ComponentInterface.
 var _ components.ComponentInterface = (*DataSciencePipelines)(nil)
 
+// registration describes Data Science Pipelines to the operator.
+var registration = components.Registration{
+	Name:             ComponentName,
+	Field:            "datasciencepipelines",
+	ManifestsFolders: []string{ComponentName},
+	ImageParams: map[string]string{
+		// v1
+		"IMAGES_APISERVER":         "RELATED_IMAGE_ODH_ML_PIPELINES_API_SERVER_IMAGE",
+		"IMAGES_ARTIFACT":          "RELATED_IMAGE_ODH_ML_PIPELINES_ARTIFACT_MANAGER_IMAGE",
+		"IMAGES_PERSISTENTAGENT":   "RELATED_IMAGE_ODH_ML_PIPELINES_PERSISTENCEAGENT_IMAGE",
+		"IMAGES_SCHEDULEDWORKFLOW": "RELATED_IMAGE_ODH_ML_PIPELINES_SCHEDULEDWORKFLOW_IMAGE",
+		"IMAGES_CACHE":             "RELATED_IMAGE_ODH_ML_PIPELINES_CACHE_IMAGE",
+		"IMAGES_DSPO":              "RELATED_IMAGE_ODH_DATA_SCIENCE_PIPELINES_OPERATOR_CONTROLLER_IMAGE",
+		// v2
+		"IMAGESV2_ARGO_APISERVER":          "RELATED_IMAGE_ODH_ML_PIPELINES_API_SERVER_V2_IMAGE",
+		"IMAGESV2_ARGO_PERSISTENCEAGENT":   "RELATED_IMAGE_ODH_ML_PIPELINES_PERSISTENCEAGENT_V2_IMAGE",
+		"IMAGESV2_ARGO_SCHEDULEDWORKFLOW":  "RELATED_IMAGE_ODH_ML_PIPELINES_SCHEDULEDWORKFLOW_V2_IMAGE",
+		"IMAGESV2_ARGO_ARGOEXEC":           "RELATED_IMAGE_ODH_DATA_SCIENCE_PIPELINES_ARGO_ARGOEXEC_IMAGE",
+		"IMAGESV2_ARGO_WORKFLOWCONTROLLER": "RELATED_IMAGE_ODH_DATA_SCIENCE_PIPELINES_ARGO_WORKFLOWCONTROLLER_IMAGE",
+		"V2_DRIVER_IMAGE":                  "RELATED_IMAGE_ODH_ML_PIPELINES_DRIVER_IMAGE",
+		"V2_LAUNCHER_IMAGE":                "RELATED_IMAGE_ODH_ML_PIPELINES_LAUNCHER_IMAGE",
+		"IMAGESV2_ARGO_MLMDGRPC":           "RELATED_IMAGE_ODH_MLMD_GRPC_SERVER_IMAGE",
+	},
+	MonitoringRules: []string{ComponentName},
+}
+
+func init() { //nolint:gochecknoinits
+	components.Register(registration)
+}
+
 // DataSciencePipelines struct holds the configuration for the DataSciencePipelines component.
 // +kubebuilder:object:generate=true
 type DataSciencePipelines struct {
 	components.Component `json:""`
 }
 
-func (d *DataSciencePipelines) OverrideManifests(ctx context.Context, _ string) error {
-	// If devflags are set, update default manifests path
-	if len(d.DevFlags.Manifests) != 0 {
-		manifestConfig := d.DevFlags.Manifests[0]
-		if err := deploy.DownloadManifests(ctx, ComponentName, manifestConfig); err != nil {
-			return err
-		}
-		// If overlay is defined, update paths
-		defaultKustomizePath := "base"
-		if manifestConfig.SourcePath != "" {
-			defaultKustomizePath = manifestConfig.SourcePath
-		}
-		Path = filepath.Join(deploy.DefaultManifestPath, ComponentName, defaultKustomizePath)
-	}
-
-	return nil
-}
-
 func (d *DataSciencePipelines) GetComponentName() string {
 	return ComponentName
 }
@@ -72,40 +93,28 @@ func (d *DataSciencePipelines) ReconcileComponent(ctx context.Context,
 	_ bool,
 ) error {
 	l := d.ConfigComponentLogger(logger, ComponentName, dscispec)
-	var imageParamMap = map[string]string{
-		// v1
-		"IMAGES_APISERVER":         "RELATED_IMAGE_ODH_ML_PIPELINES_API_SERVER_IMAGE",
-		"IMAGES_ARTIFACT":          "RELATED_IMAGE_ODH_ML_PIPELINES_ARTIFACT_MANAGER_IMAGE",
-		"IMAGES_PERSISTENTAGENT":   "RELATED_IMAGE_ODH_ML_PIPELINES_PERSISTENCEAGENT_IMAGE",
-		"IMAGES_SCHEDULEDWORKFLOW": "RELATED_IMAGE_ODH_ML_PIPELINES_SCHEDULEDWORKFLOW_IMAGE",
-		"IMAGES_CACHE":             "RELATED_IMAGE_ODH_ML_PIPELINES_CACHE_IMAGE",
-		"IMAGES_DSPO":              "RELATED_IMAGE_ODH_DATA_SCIENCE_PIPELINES_OPERATOR_CONTROLLER_IMAGE",
-		// v2
-		"IMAGESV2_ARGO_APISERVER":          "RELATED_IMAGE_ODH_ML_PIPELINES_API_SERVER_V2_IMAGE",
-		"IMAGESV2_ARGO_PERSISTENCEAGENT":   "RELATED_IMAGE_ODH_ML_PIPELINES_PERSISTENCEAGENT_V2_IMAGE",
-		"IMAGESV2_ARGO_SCHEDULEDWORKFLOW":  "RELATED_IMAGE_ODH_ML_PIPELINES_SCHEDULEDWORKFLOW_V2_IMAGE",
-		"IMAGESV2_ARGO_ARGOEXEC":           "RELATED_IMAGE_ODH_DATA_SCIENCE_PIPELINES_ARGO_ARGOEXEC_IMAGE",
-		"IMAGESV2_ARGO_WORKFLOWCONTROLLER": "RELATED_IMAGE_ODH_DATA_SCIENCE_PIPELINES_ARGO_WORKFLOWCONTROLLER_IMAGE",
-		"V2_DRIVER_IMAGE":                  "RELATED_IMAGE_ODH_ML_PIPELINES_DRIVER_IMAGE",
-		"V2_LAUNCHER_IMAGE":                "RELATED_IMAGE_ODH_ML_PIPELINES_LAUNCHER_IMAGE",
-		"IMAGESV2_ARGO_MLMDGRPC":           "RELATED_IMAGE_ODH_MLMD_GRPC_SERVER_IMAGE",
+	imageParamMap := registration.ImageParams
+
+	workspace, err := deploy.NewWorkspace(ComponentName)
+	if err != nil {
+		return err
+	}
+	defer workspace.Close()
+	// Download manifests set in devflags into the workspace
+	paramsPath, err := workspace.Resolve(ctx, cli, ComponentName, Path, d.ManifestsOverride(""))
+	if err != nil {
+		return err
 	}
 
 	enabled := d.GetManagementState() == operatorv1.Managed
 	monitoringEnabled := dscispec.Monitoring.ManagementState == operatorv1.Managed
 
 	if enabled {
-		if d.DevFlags != nil {
-			// Download manifests and update paths
-			if err := d.OverrideManifests(ctx, string(platform)); err != nil {
-				return err
-			}
-		}
 		// skip check if the dependent operator has beeninstalled, this is done in dashboard
 		// Update image parameters only when we do not have customized manifests set
 		if (dscispec.DevFlags == nil || dscispec.DevFlags.ManifestsUri == "") && (d.DevFlags == nil || len(d.DevFlags.Manifests) == 0) {
-			if err := deploy.ApplyParams(Path, imageParamMap, false); err != nil {
-				return fmt.Errorf("failed to update image from %s : %w", Path, err)
+			if err := deploy.ApplyParams(paramsPath, imageParamMap, false); err != nil {
+				return fmt.Errorf("failed to update image from %s : %w", paramsPath, err)
 			}
 		}
 		// Check for existing Argo Workflows
@@ -115,9 +124,9 @@ func (d *DataSciencePipelines) ReconcileComponent(ctx context.Context,
 	}
 
 	// new overlay
-	manifestsPath := filepath.Join(OverlayPath, "rhoai")
+	manifestsPath := workspace.Path(ComponentName, OverlayPath, "rhoai")
 	if platform == cluster.OpenDataHub || platform == "" {
-		manifestsPath = filepath.Join(OverlayPath, "odh")
+		manifestsPath = workspace.Path(ComponentName, OverlayPath, "odh")
 	}
 	if err := deploy.DeployManifestsFromPath(ctx, cli, owner, manifestsPath, dscispec.ApplicationsNamespace, ComponentName, enabled); err != nil {
 		return err
@@ -129,19 +138,13 @@ func (d *DataSciencePipelines) ReconcileComponent(ctx context.Context,
 		if enabled {
 			// first check if the service is up, so prometheus won't fire alerts when it is just startup
 			// only 1 replica should be very quick
-			if err := cluster.WaitForDeploymentAvailable(ctx, cli, ComponentName, dscispec.ApplicationsNamespace, 10, 1); err != nil {
+			if err := cluster.CheckDeploymentAvailable(ctx, cli, ComponentName, dscispec.ApplicationsNamespace); err != nil {
 				return fmt.Errorf("deployment for %s is not ready to server: %w", ComponentName, err)
 			}
 			l.Info("deployment is done, updating monitoring rules")
 		}
 
-		if err := d.UpdatePrometheusConfig(cli, enabled && monitoringEnabled, ComponentName); err != nil {
-			return err
-		}
-		if err := deploy.DeployManifestsFromPath(ctx, cli, owner,
-			filepath.Join(deploy.DefaultManifestPath, "monitoring", "prometheus", "apps"),
-			dscispec.Monitoring.Namespace,
-			"prometheus", true); err != nil {
+		if err := monitoring.UpdatePrometheusConfig(ctx, cli, owner, ComponentName, enabled && monitoringEnabled); err != nil {
 			return err
 		}
 		l.Info("updating SRE monitoring done")
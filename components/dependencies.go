@@ -0,0 +1,80 @@
+package components
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ReconcileOrder groups components into levels that can be reconciled one after another.
+// Components in the same level have no dependencies between each other and can therefore be reconciled concurrently,
+// while every component only appears in a level after all the components it depends on.
+// Dependencies on components which are not part of the given list are ignored.
+// Components keep their relative order within a level, which keeps the result stable between reconciliations.
+func ReconcileOrder(componentList []ComponentInterface) ([][]ComponentInterface, error) {
+	known := make(map[string]bool, len(componentList))
+	for _, component := range componentList {
+		name := component.GetComponentName()
+		if known[name] {
+			return nil, fmt.Errorf("component %s is defined more than once", name)
+		}
+		known[name] = true
+	}
+
+	pending := make(map[string][]string, len(componentList))
+	for _, component := range componentList {
+		var dependencies []string
+		for _, dependency := range component.GetDependencies() {
+			if known[dependency] {
+				dependencies = append(dependencies, dependency)
+			}
+		}
+		pending[component.GetComponentName()] = dependencies
+	}
+
+	var levels [][]ComponentInterface
+	done := make(map[string]bool, len(componentList))
+	for len(done) < len(componentList) {
+		var level []ComponentInterface
+		for _, component := range componentList {
+			name := component.GetComponentName()
+			if done[name] || !allDone(pending[name], done) {
+				continue
+			}
+			level = append(level, component)
+		}
+
+		if len(level) == 0 {
+			return nil, fmt.Errorf("dependency cycle detected between components: %s", strings.Join(unresolved(pending, done), ", "))
+		}
+
+		for _, component := range level {
+			done[component.GetComponentName()] = true
+		}
+		levels = append(levels, level)
+	}
+
+	return levels, nil
+}
+
+func allDone(names []string, done map[string]bool) bool {
+	for _, name := range names {
+		if !done[name] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func unresolved(pending map[string][]string, done map[string]bool) []string {
+	var names []string
+	for name := range pending {
+		if !done[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	return names
+}
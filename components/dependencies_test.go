@@ -0,0 +1,108 @@
+package components_test
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/components"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type fakeComponent struct {
+	components.Component
+	name         string
+	dependencies []string
+}
+
+func (f *fakeComponent) GetComponentName() string {
+	return f.name
+}
+
+func (f *fakeComponent) GetDependencies() []string {
+	return f.dependencies
+}
+
+func (f *fakeComponent) ReconcileComponent(_ context.Context, _ client.Client, _ logr.Logger,
+	_ metav1.Object, _ *dsciv1.DSCInitializationSpec, _ cluster.Platform, _ bool) error {
+	return nil
+}
+
+func newComponent(name string, dependencies ...string) components.ComponentInterface {
+	return &fakeComponent{name: name, dependencies: dependencies}
+}
+
+func names(levels [][]components.ComponentInterface) [][]string {
+	result := make([][]string, 0, len(levels))
+	for _, level := range levels {
+		var levelNames []string
+		for _, component := range level {
+			levelNames = append(levelNames, component.GetComponentName())
+		}
+		result = append(result, levelNames)
+	}
+
+	return result
+}
+
+var _ = Describe("Ordering components by their dependencies", func() {
+
+	It("should reconcile independent components in a single level", func() {
+		levels, err := components.ReconcileOrder([]components.ComponentInterface{
+			newComponent("dashboard"), newComponent("ray"), newComponent("kueue"),
+		})
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(names(levels)).To(Equal([][]string{{"dashboard", "ray", "kueue"}}))
+	})
+
+	It("should reconcile dependencies before dependent components", func() {
+		levels, err := components.ReconcileOrder([]components.ComponentInterface{
+			newComponent("codeflare", "ray"),
+			newComponent("kserve", "model-mesh"),
+			newComponent("model-mesh"),
+			newComponent("ray"),
+			newComponent("dashboard"),
+		})
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(names(levels)).To(Equal([][]string{
+			{"model-mesh", "ray", "dashboard"},
+			{"codeflare", "kserve"},
+		}))
+	})
+
+	It("should ignore dependencies which are not part of the reconciled components", func() {
+		levels, err := components.ReconcileOrder([]components.ComponentInterface{
+			newComponent("codeflare", "ray"),
+		})
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(names(levels)).To(Equal([][]string{{"codeflare"}}))
+	})
+
+	It("should fail when dependencies form a cycle", func() {
+		_, err := components.ReconcileOrder([]components.ComponentInterface{
+			newComponent("dashboard"),
+			newComponent("a", "b"),
+			newComponent("b", "c"),
+			newComponent("c", "a"),
+		})
+
+		Expect(err).To(MatchError(ContainSubstring("dependency cycle detected between components: a, b, c")))
+	})
+
+	It("should fail when a component is defined twice", func() {
+		_, err := components.ReconcileOrder([]components.ComponentInterface{
+			newComponent("ray"), newComponent("ray"),
+		})
+
+		Expect(err).To(MatchError(ContainSubstring("component ray is defined more than once")))
+	})
+})
@@ -0,0 +1,141 @@
+// Package external deploys components declared with a ComponentDefinition, which are shipped outside of the operator
+// but managed by DataScienceClusters like the built-in components.
+package external
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/datasciencecluster/v1"
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/components"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+)
+
+// FolderPrefix prefixes the folder of the manifests workspace holding the manifests of an external component, which
+// keeps them apart from the folders of the built-in components.
+const FolderPrefix = "external-"
+
+// Verifies that External implements ComponentInterface and WorkloadsSelector.
+var (
+	_ components.ComponentInterface = (*External)(nil)
+	_ components.WorkloadsSelector  = (*External)(nil)
+)
+
+// External is a component declared with a ComponentDefinition, named after it.
+type External struct {
+	components.Component
+	Definition *dscv1.ComponentDefinition
+}
+
+// New returns the component declared by the given ComponentDefinition.
+func New(definition *dscv1.ComponentDefinition) *External {
+	return &External{
+		Component:  *definition.Spec.Component.DeepCopy(),
+		Definition: definition,
+	}
+}
+
+func (e *External) GetComponentName() string {
+	return e.Definition.Name
+}
+
+// GetManagementState returns Removed once the ComponentDefinition is being deleted, so the component is removed from
+// the DataScienceClusters before the definition goes away.
+func (e *External) GetManagementState() operatorv1.ManagementState {
+	if !e.Definition.DeletionTimestamp.IsZero() {
+		return operatorv1.Removed
+	}
+
+	return e.ManagementState
+}
+
+// GetDependencies returns the components listed in the ComponentDefinition.
+func (e *External) GetDependencies() []string {
+	return e.Definition.Spec.Dependencies
+}
+
+// Namespace returns the namespace the component is deployed to, given the spec of the DSCInitialization as prepared for
+// the DataScienceCluster.
+func (e *External) Namespace(dscispec *dsciv1.DSCInitializationSpec) string {
+	if e.Definition.Spec.TargetNamespace != "" {
+		return e.Definition.Spec.TargetNamespace
+	}
+
+	return dscispec.ApplicationsNamespace
+}
+
+func (e *External) ReconcileComponent(ctx context.Context, cli client.Client, logger logr.Logger,
+	owner metav1.Object, dscispec *dsciv1.DSCInitializationSpec, _ cluster.Platform, _ bool) error {
+	componentName := e.GetComponentName()
+	namespace := e.Namespace(dscispec)
+	l := e.ConfigComponentLogger(logger, componentName, dscispec)
+
+	workspace, err := deploy.NewWorkspace(componentName)
+	if err != nil {
+		return err
+	}
+	defer workspace.Close()
+	// Manifests set in devflags take precedence over the ones of the definition
+	folder := FolderPrefix + componentName
+	var manifestsPath string
+	if override := e.ManifestsOverride(""); override != nil {
+		manifestsPath, err = workspace.Resolve(ctx, cli, folder, "", override)
+	} else {
+		manifestsPath, err = workspace.Fetch(ctx, cli, folder, e.Definition.Spec.Manifests)
+	}
+	if err != nil {
+		return err
+	}
+
+	enabled := e.GetManagementState() == operatorv1.Managed
+	if enabled && e.Definition.Spec.TargetNamespace != "" {
+		if _, err := cluster.CreateNamespace(ctx, cli, namespace, cluster.WithLabels(labels.ODH.OwnedNamespace, "true")); err != nil {
+			return err
+		}
+	}
+
+	params := map[string]string{"namespace": namespace}
+	for param, image := range e.Definition.Spec.ImageParams {
+		params[param] = image
+	}
+	if err := deploy.SetParams(manifestsPath, params); err != nil {
+		return fmt.Errorf("failed to update params of %s: %w", manifestsPath, err)
+	}
+
+	if err := deploy.DeployManifestsFromPath(ctx, cli, owner, manifestsPath, namespace, componentName, enabled); err != nil {
+		return err
+	}
+	l.Info("apply manifests done")
+
+	return nil
+}
+
+// ReadinessWorkloads returns the readiness Deployments of the definition when set, which may not be deployed from the
+// manifests, e.g. when the component deploys an operator creating them.
+func (e *External) ReadinessWorkloads(deployed []corev1.ObjectReference, dscispec *dsciv1.DSCInitializationSpec) []corev1.ObjectReference {
+	if len(e.Definition.Spec.ReadinessDeployments) == 0 {
+		return deployed
+	}
+
+	workloads := make([]corev1.ObjectReference, 0, len(e.Definition.Spec.ReadinessDeployments))
+	for _, name := range e.Definition.Spec.ReadinessDeployments {
+		workloads = append(workloads, corev1.ObjectReference{
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+			Kind:       "Deployment",
+			Namespace:  e.Namespace(dscispec),
+			Name:       name,
+		})
+	}
+
+	return workloads
+}
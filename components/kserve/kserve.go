@@ -5,7 +5,6 @@ package kserve
 import (
 	"context"
 	"fmt"
-	"path/filepath"
 	"strings"
 
 	"github.com/go-logr/logr"
@@ -16,15 +15,42 @@ import (
 	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
 	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/components"
+	"github.com/opendatahub-io/opendatahub-operator/v2/components/modelmeshserving"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/monitoring"
 )
 
+// Serverless prerequisite
+// +kubebuilder:rbac:groups="networking.istio.io",resources=gateways,verbs=*
+// +kubebuilder:rbac:groups="operator.knative.dev",resources=knativeservings,verbs=*
+// +kubebuilder:rbac:groups="config.openshift.io",resources=ingresses,verbs=get
+
+// +kubebuilder:rbac:groups="serving.kserve.io",resources=trainedmodels/status,verbs=update;patch;delete;get
+// +kubebuilder:rbac:groups="serving.kserve.io",resources=trainedmodels,verbs=create;delete;list;update;watch;patch;get
+// +kubebuilder:rbac:groups="serving.kserve.io",resources=servingruntimes/status,verbs=update;patch;get
+// +kubebuilder:rbac:groups="serving.kserve.io",resources=servingruntimes/finalizers,verbs=create;delete;list;update;watch;patch;get
+// +kubebuilder:rbac:groups="serving.kserve.io",resources=servingruntimes,verbs=*
+// +kubebuilder:rbac:groups="serving.kserve.io",resources=predictors/status,verbs=update;patch;delete;get
+// +kubebuilder:rbac:groups="serving.kserve.io",resources=predictors/finalizers,verbs=update;patch;get
+// +kubebuilder:rbac:groups="serving.kserve.io",resources=predictors,verbs=create;delete;list;update;watch;patch;get
+// +kubebuilder:rbac:groups="serving.kserve.io",resources=inferenceservices/status,verbs=update;patch;delete;get
+// +kubebuilder:rbac:groups="serving.kserve.io",resources=inferenceservices/finalizers,verbs=create;delete;list;update;watch;patch;get
+// +kubebuilder:rbac:groups="serving.kserve.io",resources=inferenceservices,verbs=create;delete;list;update;watch;patch;get
+// +kubebuilder:rbac:groups="serving.kserve.io",resources=inferencegraphs/status,verbs=update;patch;delete;get
+// +kubebuilder:rbac:groups="serving.kserve.io",resources=inferencegraphs,verbs=create;delete;list;update;watch;patch;get
+// +kubebuilder:rbac:groups="serving.kserve.io",resources=clusterservingruntimes/status,verbs=update;patch;delete;get
+// +kubebuilder:rbac:groups="serving.kserve.io",resources=clusterservingruntimes/finalizers,verbs=create;delete;list;update;watch;patch;get
+// +kubebuilder:rbac:groups="serving.kserve.io",resources=clusterservingruntimes,verbs=create;delete;list;update;watch;patch;get
+// +kubebuilder:rbac:groups="serving.knative.dev",resources=services/status,verbs=update;patch;delete;get
+// +kubebuilder:rbac:groups="serving.knative.dev",resources=services/finalizers,verbs=create;delete;list;watch;update;patch;get
+// +kubebuilder:rbac:groups="serving.knative.dev",resources=services,verbs=create;delete;list;watch;update;patch;get
+
 var (
 	ComponentName          = "kserve"
-	Path                   = deploy.DefaultManifestPath + "/" + ComponentName + "/overlays/odh"
+	Path                   = "overlays/odh"
 	DependentComponentName = "odh-model-controller"
-	DependentPath          = deploy.DefaultManifestPath + "/" + DependentComponentName + "/base"
+	DependentPath          = "base"
 	ServiceMeshOperator    = "servicemeshoperator"
 	ServerlessOperator     = "serverless-operator"
 )
@@ -32,6 +58,19 @@ var (
 // Verifies that Kserve implements ComponentInterface.
 var _ components.ComponentInterface = (*Kserve)(nil)
 
+// registration describes Kserve to the operator. Images of odh-model-controller, deployed along with it, are set from
+// the params of its own folder.
+var registration = components.Registration{
+	Name:             ComponentName,
+	Field:            "kserve",
+	ManifestsFolders: []string{ComponentName, DependentComponentName},
+	MonitoringRules:  []string{ComponentName},
+}
+
+func init() { //nolint:gochecknoinits
+	components.Register(registration)
+}
+
 // +kubebuilder:validation:Pattern=`^(Serverless|RawDeployment)$`
 type DefaultDeploymentMode string
 
@@ -51,43 +90,51 @@ type Kserve struct {
 	Serving infrav1.ServingSpec `json:"serving,omitempty"`
 	// Configures the default deployment mode for Kserve. This can be set to 'Serverless' or 'RawDeployment'.
 	// The value specified in this field will be used to set the default deployment mode in the 'inferenceservice-config' configmap for Kserve.
-	// This field is optional. If no default deployment mode is specified, Kserve will use Serverless mode.
+	// This field is optional. If no default deployment mode is specified, Kserve will use Serverless mode, or RawDeployment
+	// when serving is Removed, and the mode is set on the object when it is created or updated.
 	// +kubebuilder:validation:Enum=Serverless;RawDeployment
 	DefaultDeploymentMode DefaultDeploymentMode `json:"defaultDeploymentMode,omitempty"`
 }
 
-func (k *Kserve) OverrideManifests(ctx context.Context, _ string) error {
-	// Download manifests if defined by devflags
-	// Go through each manifest and set the overlays if defined
-	for _, subcomponent := range k.DevFlags.Manifests {
-		if strings.Contains(subcomponent.URI, DependentComponentName) {
-			// Download subcomponent
-			if err := deploy.DownloadManifests(ctx, DependentComponentName, subcomponent); err != nil {
-				return err
-			}
-			// If overlay is defined, update paths
-			defaultKustomizePath := "base"
-			if subcomponent.SourcePath != "" {
-				defaultKustomizePath = subcomponent.SourcePath
-			}
-			DependentPath = filepath.Join(deploy.DefaultManifestPath, DependentComponentName, defaultKustomizePath)
-		}
+// manifestsPaths are the paths of the manifests to render, resolved in the workspace of a reconcile.
+type manifestsPaths struct {
+	path          string
+	dependentPath string
+}
 
-		if strings.Contains(subcomponent.URI, ComponentName) {
-			// Download subcomponent
-			if err := deploy.DownloadManifests(ctx, ComponentName, subcomponent); err != nil {
-				return err
-			}
-			// If overlay is defined, update paths
-			defaultKustomizePath := "overlays/odh"
-			if subcomponent.SourcePath != "" {
-				defaultKustomizePath = subcomponent.SourcePath
-			}
-			Path = filepath.Join(deploy.DefaultManifestPath, ComponentName, defaultKustomizePath)
-		}
+// resolveManifests downloads manifests set in devflags into the workspace, in place of the built-in ones.
+func (k *Kserve) resolveManifests(ctx context.Context, cli client.Client, workspace *deploy.Workspace) (manifestsPaths, error) {
+	paths := manifestsPaths{}
+	var err error
+	if paths.path, err = workspace.Resolve(ctx, cli, ComponentName, Path, k.ManifestsOverride(ComponentName)); err != nil {
+		return paths, err
 	}
+	paths.dependentPath, err = workspace.Resolve(ctx, cli, DependentComponentName, DependentPath, k.ManifestsOverride(DependentComponentName))
 
-	return nil
+	return paths, err
+}
+
+// EffectiveDeploymentMode returns the deployment mode used by Kserve, Serverless when DefaultDeploymentMode is not set,
+// unless Serving is Removed.
+func (k *Kserve) EffectiveDeploymentMode() DefaultDeploymentMode {
+	switch {
+	case k.DefaultDeploymentMode != "":
+		return k.DefaultDeploymentMode
+	case k.Serving.ManagementState == operatorv1.Removed:
+		return RawDeployment
+	default:
+		return Serverless
+	}
+}
+
+// GetDependencies makes sure ModelMesh is reconciled first, as both components deploy the shared odh-model-controller.
+func (k *Kserve) GetDependencies() []string {
+	return []string{modelmeshserving.ComponentName}
+}
+
+// GetPreservedFields keeps resources of the KServe Deployments, in addition to the fields listed in the component spec.
+func (k *Kserve) GetPreservedFields() []infrav1.FieldPreservationPolicy {
+	return append([]infrav1.FieldPreservationPolicy{components.PreservedContainerResources}, k.PreservedFields...)
 }
 
 func (k *Kserve) GetComponentName() string {
@@ -98,13 +145,23 @@ func (k *Kserve) ReconcileComponent(ctx context.Context, cli client.Client,
 	logger logr.Logger, owner metav1.Object, dscispec *dsciv1.DSCInitializationSpec, platform cluster.Platform, _ bool) error {
 	l := k.ConfigComponentLogger(logger, ComponentName, dscispec)
 	// paramMap for Kserve to use.
-	var imageParamMap = map[string]string{}
+	imageParamMap := registration.ImageParams
 
 	// dependentParamMap for odh-model-controller to use.
 	var dependentParamMap = map[string]string{
 		"odh-model-controller": "RELATED_IMAGE_ODH_MODEL_CONTROLLER_IMAGE",
 	}
 
+	workspace, err := deploy.NewWorkspace(ComponentName)
+	if err != nil {
+		return err
+	}
+	defer workspace.Close()
+	paths, err := k.resolveManifests(ctx, cli, workspace)
+	if err != nil {
+		return err
+	}
+
 	enabled := k.GetManagementState() == operatorv1.Managed
 	monitoringEnabled := dscispec.Monitoring.ManagementState == operatorv1.Managed
 
@@ -114,36 +171,30 @@ func (k *Kserve) ReconcileComponent(ctx context.Context, cli client.Client,
 		}
 	} else {
 		// Configure dependencies
-		if err := k.configureServerless(ctx, dscispec); err != nil {
+		if err := k.configureServerless(ctx, dscispec, l); err != nil {
 			return err
 		}
-		if k.DevFlags != nil {
-			// Download manifests and update paths
-			if err := k.OverrideManifests(ctx, string(platform)); err != nil {
-				return err
-			}
-		}
 	}
 
 	// Update image parameters only when we do not have customized manifests set
 	if (dscispec.DevFlags == nil || dscispec.DevFlags.ManifestsUri == "") && (k.DevFlags == nil || len(k.DevFlags.Manifests) == 0) {
-		if err := deploy.ApplyParams(Path, imageParamMap, false); err != nil {
-			return fmt.Errorf("failed to update image from %s : %w", Path, err)
+		if err := deploy.ApplyParams(paths.path, imageParamMap, false); err != nil {
+			return fmt.Errorf("failed to update image from %s : %w", paths.path, err)
 		}
 	}
 
-	if err := k.configureServiceMesh(ctx, cli, dscispec); err != nil {
+	if err := k.configureServiceMesh(ctx, cli, dscispec, l); err != nil {
 		return fmt.Errorf("failed configuring service mesh while reconciling kserve component. cause: %w", err)
 	}
 
-	if err := deploy.DeployManifestsFromPath(ctx, cli, owner, Path, dscispec.ApplicationsNamespace, ComponentName, enabled); err != nil {
-		return fmt.Errorf("failed to apply manifests from %s : %w", Path, err)
+	if err := deploy.DeployManifestsFromPath(ctx, cli, owner, paths.path, dscispec.ApplicationsNamespace, ComponentName, enabled); err != nil {
+		return fmt.Errorf("failed to apply manifests from %s : %w", paths.path, err)
 	}
 
-	l.WithValues("Path", Path).Info("apply manifests done for kserve")
+	l.WithValues("Path", paths.path).Info("apply manifests done for kserve")
 
 	if enabled {
-		if err := k.setupKserveConfig(ctx, cli, dscispec); err != nil {
+		if err := k.setupKserveConfig(ctx, cli, dscispec, l); err != nil {
 			return err
 		}
 
@@ -153,30 +204,30 @@ func (k *Kserve) ReconcileComponent(ctx context.Context, cli client.Client,
 		}
 		// Update image parameters for odh-model-controller
 		if (dscispec.DevFlags == nil || dscispec.DevFlags.ManifestsUri == "") && (k.DevFlags == nil || len(k.DevFlags.Manifests) == 0) {
-			if err := deploy.ApplyParams(DependentPath, dependentParamMap, false); err != nil {
-				return fmt.Errorf("failed to update image %s: %w", DependentPath, err)
+			if err := deploy.ApplyParams(paths.dependentPath, dependentParamMap, false); err != nil {
+				return fmt.Errorf("failed to update image %s: %w", paths.dependentPath, err)
 			}
 		}
 	}
 
-	if err := deploy.DeployManifestsFromPath(ctx, cli, owner, DependentPath, dscispec.ApplicationsNamespace, ComponentName, enabled); err != nil {
+	if err := deploy.DeployManifestsFromPath(ctx, cli, owner, paths.dependentPath, dscispec.ApplicationsNamespace, ComponentName, enabled); err != nil {
 		if !strings.Contains(err.Error(), "spec.selector") || !strings.Contains(err.Error(), "field is immutable") {
 			// explicitly ignore error if error contains keywords "spec.selector" and "field is immutable" and return all other error.
 			return err
 		}
 	}
-	l.WithValues("Path", Path).Info("apply manifests done for odh-model-controller")
+	l.WithValues("Path", paths.path).Info("apply manifests done for odh-model-controller")
 	// CloudService Monitoring handling
 	if platform == cluster.ManagedRhods {
 		if enabled {
 			// first check if the service is up, so prometheus won't fire alerts when it is just startup
-			if err := cluster.WaitForDeploymentAvailable(ctx, cli, ComponentName, dscispec.ApplicationsNamespace, 20, 2); err != nil {
+			if err := cluster.CheckDeploymentAvailable(ctx, cli, ComponentName, dscispec.ApplicationsNamespace); err != nil {
 				return fmt.Errorf("deployment for %s is not ready to server: %w", ComponentName, err)
 			}
 			l.Info("deployment is done, updating monitoing rules")
 		}
 		// kesrve rules
-		if err := k.UpdatePrometheusConfig(cli, enabled && monitoringEnabled, ComponentName); err != nil {
+		if err := monitoring.UpdatePrometheusConfig(ctx, cli, owner, ComponentName, enabled && monitoringEnabled); err != nil {
 			return err
 		}
 		l.Info("updating SRE monitoring done")
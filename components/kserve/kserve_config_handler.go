@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/go-logr/logr"
 	operatorv1 "github.com/openshift/api/operator/v1"
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -19,28 +20,22 @@ const (
 	KserveConfigMapName string = "inferenceservice-config"
 )
 
-func (k *Kserve) setupKserveConfig(ctx context.Context, cli client.Client, dscispec *dsciv1.DSCInitializationSpec) error {
+func (k *Kserve) setupKserveConfig(ctx context.Context, cli client.Client, dscispec *dsciv1.DSCInitializationSpec, l logr.Logger) error {
 	// as long as Kserve.Serving is not 'Removed', we will setup the dependencies
 
 	switch k.Serving.ManagementState {
 	case operatorv1.Managed, operatorv1.Unmanaged:
-		if k.DefaultDeploymentMode == "" {
-			// if the default mode is empty in the DSC, assume mode is "Serverless" since k.Serving is Managed
-			if err := k.setDefaultDeploymentMode(ctx, cli, dscispec, Serverless); err != nil {
-				return err
-			}
-		} else {
-			// if the default mode is explicitly specified, respect that
-			if err := k.setDefaultDeploymentMode(ctx, cli, dscispec, k.DefaultDeploymentMode); err != nil {
-				return err
-			}
+		// if the default mode is empty in the DSC, e.g. created before the mutating webhook, assume mode is "Serverless"
+		// since k.Serving is Managed
+		if err := k.setDefaultDeploymentMode(ctx, cli, dscispec, k.EffectiveDeploymentMode()); err != nil {
+			return err
 		}
 	case operatorv1.Removed:
 		if k.DefaultDeploymentMode == Serverless {
 			return errors.New("setting defaultdeployment mode as Serverless is incompatible with having Serving 'Removed'")
 		}
 		if k.DefaultDeploymentMode == "" {
-			fmt.Println("Serving is removed, Kserve will default to rawdeployment")
+			l.Info("Serving is removed, Kserve will default to rawdeployment")
 		}
 		if err := k.setDefaultDeploymentMode(ctx, cli, dscispec, RawDeployment); err != nil {
 			return err
@@ -115,13 +110,13 @@ func (k *Kserve) setDefaultDeploymentMode(ctx context.Context, cli client.Client
 	return nil
 }
 
-func (k *Kserve) configureServerless(ctx context.Context, instance *dsciv1.DSCInitializationSpec) error {
+func (k *Kserve) configureServerless(ctx context.Context, instance *dsciv1.DSCInitializationSpec, l logr.Logger) error {
 	switch k.Serving.ManagementState {
 	case operatorv1.Unmanaged: // Bring your own CR
-		fmt.Println("Serverless CR is not configured by the operator, we won't do anything")
+		l.Info("Serverless CR is not configured by the operator, we won't do anything")
 
 	case operatorv1.Removed: // we remove serving CR
-		fmt.Println("existing Serverless CR (owned by operator) will be removed")
+		l.Info("existing Serverless CR (owned by operator) will be removed")
 		if err := k.removeServerlessFeatures(ctx, instance); err != nil {
 			return err
 		}
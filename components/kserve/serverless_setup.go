@@ -11,7 +11,7 @@ import (
 
 func (k *Kserve) configureServerlessFeatures() feature.FeaturesProvider {
 	return func(handler *feature.FeaturesHandler) error {
-		servingDeploymentErr := feature.CreateFeature("serverless-serving-deployment").
+		servingDeploymentErr := feature.CreateFeature(feature.ServerlessServingDeployment).
 			For(handler).
 			ManifestsLocation(Resources.Location).
 			Manifests(
@@ -32,8 +32,9 @@ func (k *Kserve) configureServerlessFeatures() feature.FeaturesProvider {
 			return servingDeploymentErr
 		}
 
-		servingNetIstioSecretFilteringErr := feature.CreateFeature("serverless-net-istio-secret-filtering").
+		servingNetIstioSecretFilteringErr := feature.CreateFeature(feature.ServerlessNetIstioSecretFiltering).
 			For(handler).
+			DependsOn(feature.ServerlessServingDeployment).
 			ManifestsLocation(Resources.Location).
 			Manifests(
 				path.Join(Resources.BaseDir, "serving-net-istio-secret-filtering.patch.tmpl.yaml"),
@@ -48,8 +49,9 @@ func (k *Kserve) configureServerlessFeatures() feature.FeaturesProvider {
 			return servingNetIstioSecretFilteringErr
 		}
 
-		serverlessGwErr := feature.CreateFeature("serverless-serving-gateways").
+		serverlessGwErr := feature.CreateFeature(feature.ServerlessServingGateways).
 			For(handler).
+			DependsOn(feature.ServerlessServingDeployment).
 			PreConditions(serverless.EnsureServerlessServingDeployed).
 			WithData(
 				PopulateComponentSettings(k),
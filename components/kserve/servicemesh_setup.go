@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"path"
 
+	"github.com/go-logr/logr"
 	operatorv1 "github.com/openshift/api/operator/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -14,10 +15,10 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/servicemesh"
 )
 
-func (k *Kserve) configureServiceMesh(ctx context.Context, cli client.Client, dscispec *dsciv1.DSCInitializationSpec) error {
+func (k *Kserve) configureServiceMesh(ctx context.Context, cli client.Client, dscispec *dsciv1.DSCInitializationSpec, l logr.Logger) error {
 	if dscispec.ServiceMesh != nil {
 		if dscispec.ServiceMesh.ManagementState == operatorv1.Managed && k.GetManagementState() == operatorv1.Managed {
-			serviceMeshInitializer := feature.ComponentFeaturesHandler(k.GetComponentName(), dscispec, k.defineServiceMeshFeatures(ctx, cli))
+			serviceMeshInitializer := feature.ComponentFeaturesHandler(k.GetComponentName(), dscispec, k.defineServiceMeshFeatures(ctx, cli, l))
 			return serviceMeshInitializer.Apply(ctx)
 		}
 		if dscispec.ServiceMesh.ManagementState == operatorv1.Unmanaged && k.GetManagementState() == operatorv1.Managed {
@@ -29,11 +30,12 @@ func (k *Kserve) configureServiceMesh(ctx context.Context, cli client.Client, ds
 }
 
 func (k *Kserve) removeServiceMeshConfigurations(ctx context.Context, cli client.Client, dscispec *dsciv1.DSCInitializationSpec) error {
-	serviceMeshInitializer := feature.ComponentFeaturesHandler(k.GetComponentName(), dscispec, k.defineServiceMeshFeatures(ctx, cli))
+	// Features are only looked up to be deleted, a missing Authorino operator is not worth a warning
+	serviceMeshInitializer := feature.ComponentFeaturesHandler(k.GetComponentName(), dscispec, k.defineServiceMeshFeatures(ctx, cli, logr.Discard()))
 	return serviceMeshInitializer.Delete(ctx)
 }
 
-func (k *Kserve) defineServiceMeshFeatures(ctx context.Context, cli client.Client) feature.FeaturesProvider {
+func (k *Kserve) defineServiceMeshFeatures(ctx context.Context, cli client.Client, l logr.Logger) feature.FeaturesProvider {
 	return func(handler *feature.FeaturesHandler) error {
 		authorinoInstalled, err := cluster.SubscriptionExists(ctx, cli, "authorino-operator")
 		if err != nil {
@@ -41,7 +43,7 @@ func (k *Kserve) defineServiceMeshFeatures(ctx context.Context, cli client.Clien
 		}
 
 		if authorinoInstalled {
-			kserveExtAuthzErr := feature.CreateFeature("kserve-external-authz").
+			kserveExtAuthzErr := feature.CreateFeature(feature.KserveExternalAuthz).
 				For(handler).
 				ManifestsLocation(Resources.Location).
 				Manifests(
@@ -57,10 +59,10 @@ func (k *Kserve) defineServiceMeshFeatures(ctx context.Context, cli client.Clien
 				return kserveExtAuthzErr
 			}
 		} else {
-			fmt.Println("WARN: Authorino operator is not installed on the cluster, skipping authorization capability")
+			l.Info("WARN: Authorino operator is not installed on the cluster, skipping authorization capability")
 		}
 
-		temporaryFixesErr := feature.CreateFeature("kserve-temporary-fixes").
+		temporaryFixesErr := feature.CreateFeature(feature.KserveTemporaryFixes).
 			For(handler).
 			ManifestsLocation(Resources.Location).
 			Manifests(
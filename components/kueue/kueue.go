@@ -4,7 +4,6 @@ package kueue
 import (
 	"context"
 	"fmt"
-	"path/filepath"
 
 	"github.com/go-logr/logr"
 	operatorv1 "github.com/openshift/api/operator/v1"
@@ -15,40 +14,38 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/components"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/monitoring"
 )
 
 var (
 	ComponentName = "kueue"
-	Path          = deploy.DefaultManifestPath + "/" + ComponentName + "/rhoai" // same path for both odh and rhoai
+	Path          = "rhoai" // same path for both odh and rhoai
 )
 
 // Verifies that Kueue implements ComponentInterface.
 var _ components.ComponentInterface = (*Kueue)(nil)
 
+// registration describes Kueue to the operator.
+var registration = components.Registration{
+	Name:             ComponentName,
+	Field:            "kueue",
+	ManifestsFolders: []string{ComponentName},
+	ImageParams: map[string]string{
+		"odh-kueue-controller-image": "RELATED_IMAGE_ODH_KUEUE_CONTROLLER_IMAGE", // new kueue image
+	},
+	MonitoringRules: []string{ComponentName},
+}
+
+func init() { //nolint:gochecknoinits
+	components.Register(registration)
+}
+
 // Kueue struct holds the configuration for the Kueue component.
 // +kubebuilder:object:generate=true
 type Kueue struct {
 	components.Component `json:""`
 }
 
-func (k *Kueue) OverrideManifests(ctx context.Context, _ string) error {
-	// If devflags are set, update default manifests path
-	if len(k.DevFlags.Manifests) != 0 {
-		manifestConfig := k.DevFlags.Manifests[0]
-		if err := deploy.DownloadManifests(ctx, ComponentName, manifestConfig); err != nil {
-			return err
-		}
-		// If overlay is defined, update paths
-		defaultKustomizePath := "rhoai"
-		if manifestConfig.SourcePath != "" {
-			defaultKustomizePath = manifestConfig.SourcePath
-		}
-		Path = filepath.Join(deploy.DefaultManifestPath, ComponentName, defaultKustomizePath)
-	}
-
-	return nil
-}
-
 func (k *Kueue) GetComponentName() string {
 	return ComponentName
 }
@@ -56,46 +53,43 @@ func (k *Kueue) GetComponentName() string {
 func (k *Kueue) ReconcileComponent(ctx context.Context, cli client.Client, logger logr.Logger,
 	owner metav1.Object, dscispec *dsciv1.DSCInitializationSpec, platform cluster.Platform, _ bool) error {
 	l := k.ConfigComponentLogger(logger, ComponentName, dscispec)
-	var imageParamMap = map[string]string{
-		"odh-kueue-controller-image": "RELATED_IMAGE_ODH_KUEUE_CONTROLLER_IMAGE", // new kueue image
+	imageParamMap := registration.ImageParams
+
+	workspace, err := deploy.NewWorkspace(ComponentName)
+	if err != nil {
+		return err
+	}
+	defer workspace.Close()
+	// Download manifests set in devflags into the workspace
+	manifestsPath, err := workspace.Resolve(ctx, cli, ComponentName, Path, k.ManifestsOverride(""))
+	if err != nil {
+		return err
 	}
 
 	enabled := k.GetManagementState() == operatorv1.Managed
 	monitoringEnabled := dscispec.Monitoring.ManagementState == operatorv1.Managed
 	if enabled {
-		if k.DevFlags != nil {
-			// Download manifests and update paths
-			if err := k.OverrideManifests(ctx, string(platform)); err != nil {
-				return err
-			}
-		}
 		if (dscispec.DevFlags == nil || dscispec.DevFlags.ManifestsUri == "") && (k.DevFlags == nil || len(k.DevFlags.Manifests) == 0) {
-			if err := deploy.ApplyParams(Path, imageParamMap, true); err != nil {
-				return fmt.Errorf("failed to update image from %s : %w", Path, err)
+			if err := deploy.ApplyParams(manifestsPath, imageParamMap, true); err != nil {
+				return fmt.Errorf("failed to update image from %s : %w", manifestsPath, err)
 			}
 		}
 	}
 	// Deploy Kueue Operator
-	if err := deploy.DeployManifestsFromPath(ctx, cli, owner, Path, dscispec.ApplicationsNamespace, ComponentName, enabled); err != nil {
-		return fmt.Errorf("failed to apply manifetss %s: %w", Path, err)
+	if err := deploy.DeployManifestsFromPath(ctx, cli, owner, manifestsPath, dscispec.ApplicationsNamespace, ComponentName, enabled); err != nil {
+		return fmt.Errorf("failed to apply manifetss %s: %w", manifestsPath, err)
 	}
 	l.Info("apply manifests done")
 	// CloudService Monitoring handling
 	if platform == cluster.ManagedRhods {
 		if enabled {
 			// first check if the service is up, so prometheus won't fire alerts when it is just startup
-			if err := cluster.WaitForDeploymentAvailable(ctx, cli, ComponentName, dscispec.ApplicationsNamespace, 20, 2); err != nil {
+			if err := cluster.CheckDeploymentAvailable(ctx, cli, ComponentName, dscispec.ApplicationsNamespace); err != nil {
 				return fmt.Errorf("deployment for %s is not ready to server: %w", ComponentName, err)
 			}
 			l.Info("deployment is done, updating monitoring rules")
 		}
-		if err := k.UpdatePrometheusConfig(cli, enabled && monitoringEnabled, ComponentName); err != nil {
-			return err
-		}
-		if err := deploy.DeployManifestsFromPath(ctx, cli, owner,
-			filepath.Join(deploy.DefaultManifestPath, "monitoring", "prometheus", "apps"),
-			dscispec.Monitoring.Namespace,
-			"prometheus", true); err != nil {
+		if err := monitoring.UpdatePrometheusConfig(ctx, cli, owner, ComponentName, enabled && monitoringEnabled); err != nil {
 			return err
 		}
 		l.Info("updating SRE monitoring done")
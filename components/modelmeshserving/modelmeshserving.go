@@ -5,7 +5,6 @@ package modelmeshserving
 import (
 	"context"
 	"fmt"
-	"path/filepath"
 	"strings"
 
 	"github.com/go-logr/logr"
@@ -14,57 +13,69 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/components"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/monitoring"
 )
 
 var (
 	ComponentName          = "model-mesh"
-	Path                   = deploy.DefaultManifestPath + "/" + ComponentName + "/overlays/odh"
+	Path                   = "overlays/odh"
 	DependentComponentName = "odh-model-controller"
-	DependentPath          = deploy.DefaultManifestPath + "/" + DependentComponentName + "/base"
+	DependentPath          = "base"
 )
 
 // Verifies that Dashboard implements ComponentInterface.
 var _ components.ComponentInterface = (*ModelMeshServing)(nil)
 
+// registration describes ModelMesh to the operator.
+var registration = components.Registration{
+	Name:             ComponentName,
+	Field:            "modelmeshserving",
+	ManifestsFolders: []string{ComponentName, DependentComponentName},
+	ImageParams: map[string]string{
+		"odh-mm-rest-proxy":             "RELATED_IMAGE_ODH_MM_REST_PROXY_IMAGE",
+		"odh-modelmesh-runtime-adapter": "RELATED_IMAGE_ODH_MODELMESH_RUNTIME_ADAPTER_IMAGE",
+		"odh-modelmesh":                 "RELATED_IMAGE_ODH_MODELMESH_IMAGE",
+		"odh-modelmesh-controller":      "RELATED_IMAGE_ODH_MODELMESH_CONTROLLER_IMAGE",
+		"odh-model-controller":          "RELATED_IMAGE_ODH_MODEL_CONTROLLER_IMAGE",
+	},
+	MonitoringRules: []string{ComponentName, DependentComponentName},
+}
+
+func init() { //nolint:gochecknoinits
+	components.Register(registration)
+}
+
 // ModelMeshServing struct holds the configuration for the ModelMeshServing component.
 // +kubebuilder:object:generate=true
 type ModelMeshServing struct {
 	components.Component `json:""`
 }
 
-func (m *ModelMeshServing) OverrideManifests(ctx context.Context, _ string) error {
-	// Go through each manifest and set the overlays if defined
-	for _, subcomponent := range m.DevFlags.Manifests {
-		if strings.Contains(subcomponent.URI, DependentComponentName) {
-			// Download subcomponent
-			if err := deploy.DownloadManifests(ctx, DependentComponentName, subcomponent); err != nil {
-				return err
-			}
-			// If overlay is defined, update paths
-			defaultKustomizePath := "base"
-			if subcomponent.SourcePath != "" {
-				defaultKustomizePath = subcomponent.SourcePath
-			}
-			DependentPath = filepath.Join(deploy.DefaultManifestPath, DependentComponentName, defaultKustomizePath)
-		}
+// manifestsPaths are the paths of the manifests to render, resolved in the workspace of a reconcile.
+type manifestsPaths struct {
+	path          string
+	dependentPath string
+}
 
-		if strings.Contains(subcomponent.URI, ComponentName) {
-			// Download subcomponent
-			if err := deploy.DownloadManifests(ctx, ComponentName, subcomponent); err != nil {
-				return err
-			}
-			// If overlay is defined, update paths
-			defaultKustomizePath := "overlays/odh"
-			if subcomponent.SourcePath != "" {
-				defaultKustomizePath = subcomponent.SourcePath
-			}
-			Path = filepath.Join(deploy.DefaultManifestPath, ComponentName, defaultKustomizePath)
-		}
+// resolveManifests downloads manifests set in devflags into the workspace, in place of the built-in ones.
+func (m *ModelMeshServing) resolveManifests(ctx context.Context, cli client.Client, workspace *deploy.Workspace) (manifestsPaths, error) {
+	paths := manifestsPaths{}
+	var err error
+	if paths.path, err = workspace.Resolve(ctx, cli, ComponentName, Path, m.ManifestsOverride(ComponentName)); err != nil {
+		return paths, err
 	}
-	return nil
+	paths.dependentPath, err = workspace.Resolve(ctx, cli, DependentComponentName, DependentPath, m.ManifestsOverride(DependentComponentName))
+
+	return paths, err
+}
+
+// GetPreservedFields keeps resources of the ModelMesh Deployments, in addition to the fields listed in the component spec.
+func (m *ModelMeshServing) GetPreservedFields() []infrav1.FieldPreservationPolicy {
+	return append([]infrav1.FieldPreservationPolicy{components.PreservedContainerResources}, m.PreservedFields...)
 }
 
 func (m *ModelMeshServing) GetComponentName() string {
@@ -80,31 +91,28 @@ func (m *ModelMeshServing) ReconcileComponent(ctx context.Context,
 	_ bool,
 ) error {
 	l := m.ConfigComponentLogger(logger, ComponentName, dscispec)
-	var imageParamMap = map[string]string{
-		"odh-mm-rest-proxy":             "RELATED_IMAGE_ODH_MM_REST_PROXY_IMAGE",
-		"odh-modelmesh-runtime-adapter": "RELATED_IMAGE_ODH_MODELMESH_RUNTIME_ADAPTER_IMAGE",
-		"odh-modelmesh":                 "RELATED_IMAGE_ODH_MODELMESH_IMAGE",
-		"odh-modelmesh-controller":      "RELATED_IMAGE_ODH_MODELMESH_CONTROLLER_IMAGE",
-		"odh-model-controller":          "RELATED_IMAGE_ODH_MODEL_CONTROLLER_IMAGE",
-	}
+	imageParamMap := registration.ImageParams
 
 	// odh-model-controller to use
 	var dependentImageParamMap = map[string]string{
 		"odh-model-controller": "RELATED_IMAGE_ODH_MODEL_CONTROLLER_IMAGE",
 	}
 
+	workspace, err := deploy.NewWorkspace(ComponentName)
+	if err != nil {
+		return err
+	}
+	defer workspace.Close()
+	paths, err := m.resolveManifests(ctx, cli, workspace)
+	if err != nil {
+		return err
+	}
+
 	enabled := m.GetManagementState() == operatorv1.Managed
 	monitoringEnabled := dscispec.Monitoring.ManagementState == operatorv1.Managed
 
 	// Update Default rolebinding
 	if enabled {
-		if m.DevFlags != nil {
-			// Download manifests and update paths
-			if err := m.OverrideManifests(ctx, string(platform)); err != nil {
-				return err
-			}
-		}
-
 		if err := cluster.UpdatePodSecurityRolebinding(ctx, cli, dscispec.ApplicationsNamespace,
 			"modelmesh",
 			"modelmesh-controller",
@@ -114,16 +122,16 @@ func (m *ModelMeshServing) ReconcileComponent(ctx context.Context,
 		}
 		// Update image parameters
 		if (dscispec.DevFlags == nil || dscispec.DevFlags.ManifestsUri == "") && (m.DevFlags == nil || len(m.DevFlags.Manifests) == 0) {
-			if err := deploy.ApplyParams(Path, imageParamMap, false); err != nil {
-				return fmt.Errorf("failed update image from %s : %w", Path, err)
+			if err := deploy.ApplyParams(paths.path, imageParamMap, false); err != nil {
+				return fmt.Errorf("failed update image from %s : %w", paths.path, err)
 			}
 		}
 	}
 
-	if err := deploy.DeployManifestsFromPath(ctx, cli, owner, Path, dscispec.ApplicationsNamespace, ComponentName, enabled); err != nil {
-		return fmt.Errorf("failed to apply manifests from %s : %w", Path, err)
+	if err := deploy.DeployManifestsFromPath(ctx, cli, owner, paths.path, dscispec.ApplicationsNamespace, ComponentName, enabled); err != nil {
+		return fmt.Errorf("failed to apply manifests from %s : %w", paths.path, err)
 	}
-	l.WithValues("Path", Path).Info("apply manifests done for modelmesh")
+	l.WithValues("Path", paths.path).Info("apply manifests done for modelmesh")
 	// For odh-model-controller
 	if enabled {
 		if err := cluster.UpdatePodSecurityRolebinding(ctx, cli, dscispec.ApplicationsNamespace,
@@ -132,40 +140,30 @@ func (m *ModelMeshServing) ReconcileComponent(ctx context.Context,
 		}
 		// Update image parameters for odh-model-controller
 		if dscispec.DevFlags == nil || dscispec.DevFlags.ManifestsUri == "" {
-			if err := deploy.ApplyParams(DependentPath, dependentImageParamMap, false); err != nil {
+			if err := deploy.ApplyParams(paths.dependentPath, dependentImageParamMap, false); err != nil {
 				return err
 			}
 		}
 	}
-	if err := deploy.DeployManifestsFromPath(ctx, cli, owner, DependentPath, dscispec.ApplicationsNamespace, m.GetComponentName(), enabled); err != nil {
+	if err := deploy.DeployManifestsFromPath(ctx, cli, owner, paths.dependentPath, dscispec.ApplicationsNamespace, m.GetComponentName(), enabled); err != nil {
 		// explicitly ignore error if error contains keywords "spec.selector" and "field is immutable" and return all other error.
 		if !strings.Contains(err.Error(), "spec.selector") || !strings.Contains(err.Error(), "field is immutable") {
 			return err
 		}
 	}
 
-	l.WithValues("Path", DependentPath).Info("apply manifests done for odh-model-controller")
+	l.WithValues("Path", paths.dependentPath).Info("apply manifests done for odh-model-controller")
 	// CloudService Monitoring handling
 	if platform == cluster.ManagedRhods {
 		if enabled {
 			// first check if service is up, so prometheus won't fire alerts when it is just startup
-			if err := cluster.WaitForDeploymentAvailable(ctx, cli, ComponentName, dscispec.ApplicationsNamespace, 20, 2); err != nil {
+			if err := cluster.CheckDeploymentAvailable(ctx, cli, ComponentName, dscispec.ApplicationsNamespace); err != nil {
 				return fmt.Errorf("deployment for %s is not ready to server: %w", ComponentName, err)
 			}
 			l.Info("deployment is done, updating monitoring rules")
 		}
-		// first model-mesh rules
-		if err := m.UpdatePrometheusConfig(cli, enabled && monitoringEnabled, ComponentName); err != nil {
-			return err
-		}
-		// then odh-model-controller rules
-		if err := m.UpdatePrometheusConfig(cli, enabled && monitoringEnabled, DependentComponentName); err != nil {
-			return err
-		}
-		if err := deploy.DeployManifestsFromPath(ctx, cli, owner,
-			filepath.Join(deploy.DefaultManifestPath, "monitoring", "prometheus", "apps"),
-			dscispec.Monitoring.Namespace,
-			"prometheus", true); err != nil {
+		// model-mesh and odh-model-controller rules
+		if err := monitoring.UpdatePrometheusConfig(ctx, cli, owner, ComponentName, enabled && monitoringEnabled); err != nil {
 			return err
 		}
 		l.Info("updating SRE monitoring done")
@@ -5,7 +5,6 @@ package modelregistry
 import (
 	"context"
 	"fmt"
-	"path/filepath"
 
 	"github.com/go-logr/logr"
 	operatorv1 "github.com/openshift/api/operator/v1"
@@ -18,9 +17,13 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
 )
 
+//+kubebuilder:rbac:groups=modelregistry.opendatahub.io,resources=modelregistries,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=modelregistry.opendatahub.io,resources=modelregistries/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=modelregistry.opendatahub.io,resources=modelregistries/finalizers,verbs=update;get
+
 var (
 	ComponentName = "model-registry-operator"
-	Path          = deploy.DefaultManifestPath + "/" + ComponentName + "/overlays/odh"
+	Path          = "overlays/odh"
 	// we should not apply this label to the namespace, as it triggered namspace deletion during operator uninstall
 	// modelRegistryLabels = cluster.WithLabels(
 	// 	labels.ODH.OwnedNamespace, "true",
@@ -30,30 +33,28 @@ var (
 // Verifies that ModelRegistry implements ComponentInterface.
 var _ components.ComponentInterface = (*ModelRegistry)(nil)
 
+// registration describes ModelRegistry to the operator.
+var registration = components.Registration{
+	Name:             ComponentName,
+	Field:            "modelregistry",
+	ManifestsFolders: []string{ComponentName},
+	ImageParams: map[string]string{
+		"IMAGES_MODELREGISTRY_OPERATOR": "RELATED_IMAGE_ODH_MODEL_REGISTRY_OPERATOR_IMAGE",
+		"IMAGES_GRPC_SERVICE":           "RELATED_IMAGE_ODH_MLMD_GRPC_SERVER_IMAGE",
+		"IMAGES_REST_SERVICE":           "RELATED_IMAGE_ODH_MODEL_REGISTRY_IMAGE",
+	},
+}
+
+func init() { //nolint:gochecknoinits
+	components.Register(registration)
+}
+
 // ModelRegistry struct holds the configuration for the ModelRegistry component.
 // +kubebuilder:object:generate=true
 type ModelRegistry struct {
 	components.Component `json:""`
 }
 
-func (m *ModelRegistry) OverrideManifests(ctx context.Context, _ string) error {
-	// If devflags are set, update default manifests path
-	if len(m.DevFlags.Manifests) != 0 {
-		manifestConfig := m.DevFlags.Manifests[0]
-		if err := deploy.DownloadManifests(ctx, ComponentName, manifestConfig); err != nil {
-			return err
-		}
-		// If overlay is defined, update paths
-		defaultKustomizePath := "overlays/odh"
-		if manifestConfig.SourcePath != "" {
-			defaultKustomizePath = manifestConfig.SourcePath
-		}
-		Path = filepath.Join(deploy.DefaultManifestPath, ComponentName, defaultKustomizePath)
-	}
-
-	return nil
-}
-
 func (m *ModelRegistry) GetComponentName() string {
 	return ComponentName
 }
@@ -61,25 +62,26 @@ func (m *ModelRegistry) GetComponentName() string {
 func (m *ModelRegistry) ReconcileComponent(ctx context.Context, cli client.Client, logger logr.Logger,
 	owner metav1.Object, dscispec *dsciv1.DSCInitializationSpec, platform cluster.Platform, _ bool) error {
 	l := m.ConfigComponentLogger(logger, ComponentName, dscispec)
-	var imageParamMap = map[string]string{
-		"IMAGES_MODELREGISTRY_OPERATOR": "RELATED_IMAGE_ODH_MODEL_REGISTRY_OPERATOR_IMAGE",
-		"IMAGES_GRPC_SERVICE":           "RELATED_IMAGE_ODH_MLMD_GRPC_SERVER_IMAGE",
-		"IMAGES_REST_SERVICE":           "RELATED_IMAGE_ODH_MODEL_REGISTRY_IMAGE",
+	imageParamMap := registration.ImageParams
+	workspace, err := deploy.NewWorkspace(ComponentName)
+	if err != nil {
+		return err
+	}
+	defer workspace.Close()
+	// Download manifests set in devflags into the workspace
+	manifestsPath, err := workspace.Resolve(ctx, cli, ComponentName, Path, m.ManifestsOverride(""))
+	if err != nil {
+		return err
 	}
+
 	enabled := m.GetManagementState() == operatorv1.Managed
 
 	if enabled {
-		if m.DevFlags != nil {
-			// Download manifests and update paths
-			if err := m.OverrideManifests(ctx, string(platform)); err != nil {
-				return err
-			}
-		}
 
 		// Update image parameters only when we do not have customized manifests set
 		if (dscispec.DevFlags == nil || dscispec.DevFlags.ManifestsUri == "") && (m.DevFlags == nil || len(m.DevFlags.Manifests) == 0) {
-			if err := deploy.ApplyParams(Path, imageParamMap, false); err != nil {
-				return fmt.Errorf("failed to update image from %s : %w", Path, err)
+			if err := deploy.ApplyParams(manifestsPath, imageParamMap, false); err != nil {
+				return fmt.Errorf("failed to update image from %s : %w", manifestsPath, err)
 			}
 		}
 
@@ -91,13 +93,13 @@ func (m *ModelRegistry) ReconcileComponent(ctx context.Context, cli client.Clien
 		}
 	}
 	// Deploy ModelRegistry Operator
-	if err := deploy.DeployManifestsFromPath(ctx, cli, owner, Path, dscispec.ApplicationsNamespace, m.GetComponentName(), enabled); err != nil {
+	if err := deploy.DeployManifestsFromPath(ctx, cli, owner, manifestsPath, dscispec.ApplicationsNamespace, m.GetComponentName(), enabled); err != nil {
 		return err
 	}
 	l.Info("apply manifests done")
 
 	// Create additional model registry resources, componentEnabled=true because these extras are never deleted!
-	if err := deploy.DeployManifestsFromPath(ctx, cli, owner, Path+"/extras", dscispec.ApplicationsNamespace, m.GetComponentName(), true); err != nil {
+	if err := deploy.DeployManifestsFromPath(ctx, cli, owner, manifestsPath+"/extras", dscispec.ApplicationsNamespace, m.GetComponentName(), true); err != nil {
 		return err
 	}
 	l.Info("apply extra manifests done")
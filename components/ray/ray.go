@@ -6,7 +6,6 @@ package ray
 import (
 	"context"
 	"fmt"
-	"path/filepath"
 
 	"github.com/go-logr/logr"
 	operatorv1 "github.com/openshift/api/operator/v1"
@@ -17,40 +16,42 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/components"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/monitoring"
 )
 
+// +kubebuilder:rbac:groups="ray.io",resources=rayservices,verbs=create;delete;list;watch;update;patch;get
+// +kubebuilder:rbac:groups="ray.io",resources=rayjobs,verbs=create;delete;list;update;watch;patch;get
+// +kubebuilder:rbac:groups="ray.io",resources=rayclusters,verbs=create;delete;list;patch;get
+
 var (
 	ComponentName = "ray"
-	RayPath       = deploy.DefaultManifestPath + "/" + ComponentName + "/openshift"
+	RayPath       = "openshift"
 )
 
 // Verifies that Ray implements ComponentInterface.
 var _ components.ComponentInterface = (*Ray)(nil)
 
+// registration describes Ray to the operator.
+var registration = components.Registration{
+	Name:             ComponentName,
+	Field:            "ray",
+	ManifestsFolders: []string{ComponentName},
+	ImageParams: map[string]string{
+		"odh-kuberay-operator-controller-image": "RELATED_IMAGE_ODH_KUBERAY_OPERATOR_CONTROLLER_IMAGE",
+	},
+	MonitoringRules: []string{ComponentName},
+}
+
+func init() { //nolint:gochecknoinits
+	components.Register(registration)
+}
+
 // Ray struct holds the configuration for the Ray component.
 // +kubebuilder:object:generate=true
 type Ray struct {
 	components.Component `json:""`
 }
 
-func (r *Ray) OverrideManifests(ctx context.Context, _ string) error {
-	// If devflags are set, update default manifests path
-	if len(r.DevFlags.Manifests) != 0 {
-		manifestConfig := r.DevFlags.Manifests[0]
-		if err := deploy.DownloadManifests(ctx, ComponentName, manifestConfig); err != nil {
-			return err
-		}
-		// If overlay is defined, update paths
-		defaultKustomizePath := "openshift"
-		if manifestConfig.SourcePath != "" {
-			defaultKustomizePath = manifestConfig.SourcePath
-		}
-		RayPath = filepath.Join(deploy.DefaultManifestPath, ComponentName, defaultKustomizePath)
-	}
-
-	return nil
-}
-
 func (r *Ray) GetComponentName() string {
 	return ComponentName
 }
@@ -59,48 +60,44 @@ func (r *Ray) ReconcileComponent(ctx context.Context, cli client.Client, logger
 	owner metav1.Object, dscispec *dsciv1.DSCInitializationSpec, platform cluster.Platform, _ bool) error {
 	l := r.ConfigComponentLogger(logger, ComponentName, dscispec)
 
-	var imageParamMap = map[string]string{
-		"odh-kuberay-operator-controller-image": "RELATED_IMAGE_ODH_KUBERAY_OPERATOR_CONTROLLER_IMAGE",
-		"namespace":                             dscispec.ApplicationsNamespace,
+	imageParamMap := registration.ImageParamsWith(map[string]string{"namespace": dscispec.ApplicationsNamespace})
+
+	workspace, err := deploy.NewWorkspace(ComponentName)
+	if err != nil {
+		return err
+	}
+	defer workspace.Close()
+	// Download manifests set in devflags into the workspace
+	manifestsPath, err := workspace.Resolve(ctx, cli, ComponentName, RayPath, r.ManifestsOverride(""))
+	if err != nil {
+		return err
 	}
 
 	enabled := r.GetManagementState() == operatorv1.Managed
 	monitoringEnabled := dscispec.Monitoring.ManagementState == operatorv1.Managed
 
 	if enabled {
-		if r.DevFlags != nil {
-			// Download manifests and update paths
-			if err := r.OverrideManifests(ctx, string(platform)); err != nil {
-				return err
-			}
-		}
 		if (dscispec.DevFlags == nil || dscispec.DevFlags.ManifestsUri == "") && (r.DevFlags == nil || len(r.DevFlags.Manifests) == 0) {
-			if err := deploy.ApplyParams(RayPath, imageParamMap, true); err != nil {
-				return fmt.Errorf("failed to update image from %s : %w", RayPath, err)
+			if err := deploy.ApplyParams(manifestsPath, imageParamMap, true); err != nil {
+				return fmt.Errorf("failed to update image from %s : %w", manifestsPath, err)
 			}
 		}
 	}
 	// Deploy Ray Operator
-	if err := deploy.DeployManifestsFromPath(ctx, cli, owner, RayPath, dscispec.ApplicationsNamespace, ComponentName, enabled); err != nil {
-		return fmt.Errorf("failed to apply manifets from %s : %w", RayPath, err)
+	if err := deploy.DeployManifestsFromPath(ctx, cli, owner, manifestsPath, dscispec.ApplicationsNamespace, ComponentName, enabled); err != nil {
+		return fmt.Errorf("failed to apply manifets from %s : %w", manifestsPath, err)
 	}
 	l.Info("apply manifests done")
 	// CloudService Monitoring handling
 	if platform == cluster.ManagedRhods {
 		if enabled {
 			// first check if the service is up, so prometheus won't fire alerts when it is just startup
-			if err := cluster.WaitForDeploymentAvailable(ctx, cli, ComponentName, dscispec.ApplicationsNamespace, 20, 2); err != nil {
+			if err := cluster.CheckDeploymentAvailable(ctx, cli, ComponentName, dscispec.ApplicationsNamespace); err != nil {
 				return fmt.Errorf("deployment for %s is not ready to server: %w", ComponentName, err)
 			}
 			l.Info("deployment is done, updating monitoring rules")
 		}
-		if err := r.UpdatePrometheusConfig(cli, enabled && monitoringEnabled, ComponentName); err != nil {
-			return err
-		}
-		if err := deploy.DeployManifestsFromPath(ctx, cli, owner,
-			filepath.Join(deploy.DefaultManifestPath, "monitoring", "prometheus", "apps"),
-			dscispec.Monitoring.Namespace,
-			"prometheus", true); err != nil {
+		if err := monitoring.UpdatePrometheusConfig(ctx, cli, owner, ComponentName, enabled && monitoringEnabled); err != nil {
 			return err
 		}
 		l.Info("updating SRE monitoring done")
@@ -0,0 +1,122 @@
+package components
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Registration describes a component to the operator. Each component package registers itself from its init function,
+// so the controllers, the webhook and the monitoring setup iterate the registered components instead of listing them.
+type Registration struct {
+	// Name of the component, as returned by GetComponentName.
+	Name string
+	// Field is the name of the component in spec.components of DataScienceCluster.
+	Field string
+	// ManifestsFolders lists the folders of the manifests tree the component is deployed from.
+	ManifestsFolders []string
+	// ImageParams maps params of the manifests to the RELATED_IMAGE_* variables holding the images of the component.
+	ImageParams map[string]string
+	// OwnedTypes are the types of objects deployed by the component which the DataScienceCluster controller watches,
+	// in addition to the ones common to all components.
+	OwnedTypes []client.Object
+	// MonitoringRules are the prefixes of the Prometheus rules files of the component, enabled while it is Managed.
+	MonitoringRules []string
+}
+
+var (
+	registryMutex sync.RWMutex
+	registry      = map[string]Registration{}
+)
+
+// Register adds a component to the registry. It panics when the name or the field of the component is already
+// registered, as components are registered once at startup.
+func Register(registration Registration) {
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+
+	if registration.Name == "" || registration.Field == "" {
+		panic(fmt.Sprintf("component %q is registered without name or field", registration.Name))
+	}
+	for _, registered := range registry {
+		if registered.Name == registration.Name || registered.Field == registration.Field {
+			panic(fmt.Sprintf("component %s with field %s is already registered", registration.Name, registration.Field))
+		}
+	}
+	registry[registration.Name] = registration
+}
+
+// Registrations returns the registered components, sorted by name.
+func Registrations() []Registration {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+
+	registrations := make([]Registration, 0, len(registry))
+	for _, registration := range registry {
+		registrations = append(registrations, registration)
+	}
+	sort.Slice(registrations, func(i, j int) bool {
+		return registrations[i].Name < registrations[j].Name
+	})
+
+	return registrations
+}
+
+// Lookup returns the registration of the component of the given name.
+func Lookup(name string) (Registration, bool) {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+
+	registration, found := registry[name]
+
+	return registration, found
+}
+
+// LookupField returns the registration of the component defined by the given field of spec.components.
+func LookupField(field string) (Registration, bool) {
+	registryMutex.RLock()
+	defer registryMutex.RUnlock()
+
+	for _, registration := range registry {
+		if registration.Field == field {
+			return registration, true
+		}
+	}
+
+	return Registration{}, false
+}
+
+// Spec returns a pointer to the configuration of the component in the given pointer to the components of a
+// DataScienceCluster, i.e. to the field whose JSON name is Field, so that the components of each API version are
+// resolved without listing their fields. It returns nil when there is no such field.
+func (r Registration) Spec(specs interface{}) interface{} {
+	value := reflect.ValueOf(specs)
+	if value.Kind() != reflect.Pointer || value.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+	value = value.Elem()
+	for i := 0; i < value.NumField(); i++ {
+		if name, _, _ := strings.Cut(value.Type().Field(i).Tag.Get("json"), ","); name == r.Field {
+			return value.Field(i).Addr().Interface()
+		}
+	}
+
+	return nil
+}
+
+// ImageParamsWith returns the image params of the component, along with the given additional params.
+func (r Registration) ImageParamsWith(extraParams map[string]string) map[string]string {
+	params := make(map[string]string, len(r.ImageParams)+len(extraParams))
+	for key, value := range r.ImageParams {
+		params[key] = value
+	}
+	for key, value := range extraParams {
+		params[key] = value
+	}
+
+	return params
+}
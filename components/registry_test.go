@@ -0,0 +1,86 @@
+package components_test
+
+import (
+	"sort"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/components"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Registering components", Ordered, func() {
+
+	BeforeAll(func() {
+		components.Register(components.Registration{Name: "registry-test-b", Field: "registrytestb"})
+		components.Register(components.Registration{
+			Name:        "registry-test-a",
+			Field:       "registrytesta",
+			ImageParams: map[string]string{"registry-test-image": "RELATED_IMAGE_REGISTRY_TEST_IMAGE"},
+		})
+	})
+
+	It("should look up a registered component by name", func() {
+		registration, found := components.Lookup("registry-test-a")
+
+		Expect(found).To(BeTrue())
+		Expect(registration.Field).To(Equal("registrytesta"))
+
+		_, found = components.Lookup("registry-test-unknown")
+		Expect(found).To(BeFalse())
+	})
+
+	It("should list registered components sorted by name", func() {
+		var names []string
+		for _, registration := range components.Registrations() {
+			names = append(names, registration.Name)
+		}
+
+		Expect(names).To(ContainElements("registry-test-a", "registry-test-b"))
+		Expect(sort.StringsAreSorted(names)).To(BeTrue())
+	})
+
+	It("should refuse to register a component twice", func() {
+		Expect(func() {
+			components.Register(components.Registration{Name: "registry-test-a", Field: "registrytestc"})
+		}).To(Panic())
+		Expect(func() {
+			components.Register(components.Registration{Name: "registry-test-c", Field: "registrytestb"})
+		}).To(Panic())
+	})
+
+	It("should add params to the image params without changing them", func() {
+		registration, _ := components.Lookup("registry-test-a")
+
+		params := registration.ImageParamsWith(map[string]string{"namespace": "opendatahub"})
+
+		Expect(params).To(Equal(map[string]string{
+			"registry-test-image": "RELATED_IMAGE_REGISTRY_TEST_IMAGE",
+			"namespace":           "opendatahub",
+		}))
+		Expect(registration.ImageParams).ToNot(HaveKey("namespace"))
+	})
+
+	It("should look up a registered component by field", func() {
+		registration, found := components.LookupField("registrytestb")
+
+		Expect(found).To(BeTrue())
+		Expect(registration.Name).To(Equal("registry-test-b"))
+
+		_, found = components.LookupField("registrytestunknown")
+		Expect(found).To(BeFalse())
+	})
+
+	It("should resolve the configuration of a component by the JSON name of its field", func() {
+		type testComponents struct {
+			A components.Component `json:"registrytesta,omitempty"`
+			B components.Component `json:"registrytestb"`
+		}
+		specs := &testComponents{}
+		registration, _ := components.Lookup("registry-test-b")
+
+		Expect(registration.Spec(specs)).To(BeIdenticalTo(&specs.B))
+		Expect(components.Registration{Field: "registrytestunknown"}.Spec(specs)).To(BeNil())
+		Expect(registration.Spec(*specs)).To(BeNil())
+	})
+})
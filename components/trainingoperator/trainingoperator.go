@@ -6,7 +6,6 @@ package trainingoperator
 import (
 	"context"
 	"fmt"
-	"path/filepath"
 
 	"github.com/go-logr/logr"
 	operatorv1 "github.com/openshift/api/operator/v1"
@@ -17,40 +16,38 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/components"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/monitoring"
 )
 
 var (
 	ComponentName        = "trainingoperator"
-	TrainingOperatorPath = deploy.DefaultManifestPath + "/" + ComponentName + "/rhoai"
+	TrainingOperatorPath = "rhoai"
 )
 
 // Verifies that TrainingOperator implements ComponentInterface.
 var _ components.ComponentInterface = (*TrainingOperator)(nil)
 
+// registration describes the Training Operator to the operator.
+var registration = components.Registration{
+	Name:             ComponentName,
+	Field:            "trainingoperator",
+	ManifestsFolders: []string{ComponentName},
+	ImageParams: map[string]string{
+		"odh-training-operator-controller-image": "RELATED_IMAGE_ODH_TRAINING_OPERATOR_IMAGE",
+	},
+	MonitoringRules: []string{ComponentName},
+}
+
+func init() { //nolint:gochecknoinits
+	components.Register(registration)
+}
+
 // TrainingOperator struct holds the configuration for the TrainingOperator component.
 // +kubebuilder:object:generate=true
 type TrainingOperator struct {
 	components.Component `json:""`
 }
 
-func (r *TrainingOperator) OverrideManifests(ctx context.Context, _ string) error {
-	// If devflags are set, update default manifests path
-	if len(r.DevFlags.Manifests) != 0 {
-		manifestConfig := r.DevFlags.Manifests[0]
-		if err := deploy.DownloadManifests(ctx, ComponentName, manifestConfig); err != nil {
-			return err
-		}
-		// If overlay is defined, update paths
-		defaultKustomizePath := "rhoai"
-		if manifestConfig.SourcePath != "" {
-			defaultKustomizePath = manifestConfig.SourcePath
-		}
-		TrainingOperatorPath = filepath.Join(deploy.DefaultManifestPath, ComponentName, defaultKustomizePath)
-	}
-
-	return nil
-}
-
 func (r *TrainingOperator) GetComponentName() string {
 	return ComponentName
 }
@@ -59,29 +56,31 @@ func (r *TrainingOperator) ReconcileComponent(ctx context.Context, cli client.Cl
 	owner metav1.Object, dscispec *dsciv1.DSCInitializationSpec, platform cluster.Platform, _ bool) error {
 	l := r.ConfigComponentLogger(logger, ComponentName, dscispec)
 
-	var imageParamMap = map[string]string{
-		"odh-training-operator-controller-image": "RELATED_IMAGE_ODH_TRAINING_OPERATOR_IMAGE",
-		"namespace":                              dscispec.ApplicationsNamespace,
+	imageParamMap := registration.ImageParamsWith(map[string]string{"namespace": dscispec.ApplicationsNamespace})
+
+	workspace, err := deploy.NewWorkspace(ComponentName)
+	if err != nil {
+		return err
+	}
+	defer workspace.Close()
+	// Download manifests set in devflags into the workspace
+	manifestsPath, err := workspace.Resolve(ctx, cli, ComponentName, TrainingOperatorPath, r.ManifestsOverride(""))
+	if err != nil {
+		return err
 	}
 
 	enabled := r.GetManagementState() == operatorv1.Managed
 	monitoringEnabled := dscispec.Monitoring.ManagementState == operatorv1.Managed
 
 	if enabled {
-		if r.DevFlags != nil {
-			// Download manifests and update paths
-			if err := r.OverrideManifests(ctx, string(platform)); err != nil {
-				return err
-			}
-		}
 		if (dscispec.DevFlags == nil || dscispec.DevFlags.ManifestsUri == "") && (r.DevFlags == nil || len(r.DevFlags.Manifests) == 0) {
-			if err := deploy.ApplyParams(TrainingOperatorPath, imageParamMap, true); err != nil {
+			if err := deploy.ApplyParams(manifestsPath, imageParamMap, true); err != nil {
 				return err
 			}
 		}
 	}
 	// Deploy Training Operator
-	if err := deploy.DeployManifestsFromPath(ctx, cli, owner, TrainingOperatorPath, dscispec.ApplicationsNamespace, ComponentName, enabled); err != nil {
+	if err := deploy.DeployManifestsFromPath(ctx, cli, owner, manifestsPath, dscispec.ApplicationsNamespace, ComponentName, enabled); err != nil {
 		return err
 	}
 	l.Info("apply manifests done")
@@ -89,19 +88,12 @@ func (r *TrainingOperator) ReconcileComponent(ctx context.Context, cli client.Cl
 	if platform == cluster.ManagedRhods {
 		if enabled {
 			// first check if the service is up, so prometheus wont fire alerts when it is just startup
-			if err := cluster.WaitForDeploymentAvailable(ctx, cli, ComponentName, dscispec.ApplicationsNamespace, 20, 2); err != nil {
+			if err := cluster.CheckDeploymentAvailable(ctx, cli, ComponentName, dscispec.ApplicationsNamespace); err != nil {
 				return fmt.Errorf("deployment for %s is not ready to server: %w", ComponentName, err)
 			}
-			fmt.Printf("deployment for %s is done, updating monitoring rules\n", ComponentName)
 		}
 		l.Info("deployment is done, updating monitoring rules")
-		if err := r.UpdatePrometheusConfig(cli, enabled && monitoringEnabled, ComponentName); err != nil {
-			return err
-		}
-		if err := deploy.DeployManifestsFromPath(ctx, cli, owner,
-			filepath.Join(deploy.DefaultManifestPath, "monitoring", "prometheus", "apps"),
-			dscispec.Monitoring.Namespace,
-			"prometheus", true); err != nil {
+		if err := monitoring.UpdatePrometheusConfig(ctx, cli, owner, ComponentName, enabled && monitoringEnabled); err != nil {
 			return err
 		}
 		l.Info("updating SRE monitoring done")
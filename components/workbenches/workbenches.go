@@ -5,10 +5,11 @@ package workbenches
 import (
 	"context"
 	"fmt"
-	"path/filepath"
 	"strings"
 
 	"github.com/go-logr/logr"
+	buildv1 "github.com/openshift/api/build/v1"
+	imagev1 "github.com/openshift/api/image/v1"
 	operatorv1 "github.com/openshift/api/operator/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -18,77 +19,97 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/monitoring"
 )
 
+// +kubebuilder:rbac:groups="image.openshift.io",resources=imagestreams,verbs=patch;create;update;delete;get
+// +kubebuilder:rbac:groups="image.openshift.io",resources=imagestreams,verbs=create;list;watch;patch;delete;get
+
 var (
 	ComponentName          = "workbenches"
 	DependentComponentName = "notebooks"
 	// manifests for nbc in ODH and downstream + downstream use it for imageparams.
-	notebookControllerPath = deploy.DefaultManifestPath + "/odh-notebook-controller/odh-notebook-controller/base"
+	notebookControllerFolder = "odh-notebook-controller/odh-notebook-controller"
+	notebookControllerPath   = "base"
 	// manifests for ODH nbc + downstream use it for imageparams.
-	kfnotebookControllerPath    = deploy.DefaultManifestPath + "/odh-notebook-controller/kf-notebook-controller/overlays/openshift"
-	notebookImagesPath          = deploy.DefaultManifestPath + "/notebooks/overlays/additional"
-	notebookImagesPathSupported = deploy.DefaultManifestPath + "/jupyterhub/notebook-images/overlays/additional"
+	kfnotebookControllerFolder    = "odh-notebook-controller/kf-notebook-controller"
+	kfnotebookControllerPath      = "overlays/openshift"
+	notebookImagesPath            = "overlays/additional"
+	notebookImagesFolderSupported = "jupyterhub"
+	notebookImagesPathSupported   = "notebook-images/overlays/additional"
 )
 
 // Verifies that Workbench implements ComponentInterface.
 var _ components.ComponentInterface = (*Workbenches)(nil)
 
+// registration describes Workbenches to the operator. Notebook images are deployed from the notebooks folder in ODH,
+// and from jupyterhub in RHOAI.
+var registration = components.Registration{
+	Name:             ComponentName,
+	Field:            "workbenches",
+	ManifestsFolders: []string{"odh-notebook-controller"},
+	ImageParams: map[string]string{
+		"odh-notebook-controller-image":    "RELATED_IMAGE_ODH_NOTEBOOK_CONTROLLER_IMAGE",
+		"odh-kf-notebook-controller-image": "RELATED_IMAGE_ODH_KF_NOTEBOOK_CONTROLLER_IMAGE",
+	},
+	OwnedTypes:      []client.Object{&imagev1.ImageStream{}, &buildv1.BuildConfig{}},
+	MonitoringRules: []string{ComponentName},
+}
+
+func init() { //nolint:gochecknoinits
+	components.Register(registration)
+}
+
 // Workbenches struct holds the configuration for the Workbenches component.
 // +kubebuilder:object:generate=true
 type Workbenches struct {
 	components.Component `json:""`
 }
 
-func (w *Workbenches) OverrideManifests(ctx context.Context, platform string) error {
-	// Download manifests if defined by devflags
-	// Go through each manifest and set the overlays if defined
-	for _, subcomponent := range w.DevFlags.Manifests {
-		if strings.Contains(subcomponent.URI, DependentComponentName) {
-			// Download subcomponent
-			if err := deploy.DownloadManifests(ctx, DependentComponentName, subcomponent); err != nil {
-				return err
-			}
-			// If overlay is defined, update paths
-			defaultKustomizePath := "overlays/additional"
-			defaultKustomizePathSupported := "notebook-images/overlays/additional"
-			if subcomponent.SourcePath != "" {
-				defaultKustomizePath = subcomponent.SourcePath
-				defaultKustomizePathSupported = subcomponent.SourcePath
-			}
-			if platform == string(cluster.ManagedRhods) || platform == string(cluster.SelfManagedRhods) {
-				notebookImagesPathSupported = filepath.Join(deploy.DefaultManifestPath, "jupyterhub", defaultKustomizePathSupported)
-			} else {
-				notebookImagesPath = filepath.Join(deploy.DefaultManifestPath, DependentComponentName, defaultKustomizePath)
-			}
-		}
+// manifestsPaths are the paths of the manifests to render, resolved in the workspace of a reconcile.
+type manifestsPaths struct {
+	notebookController   string
+	kfNotebookController string
+	notebookImages       string
+}
 
-		if strings.Contains(subcomponent.ContextDir, "components/odh-notebook-controller") {
-			// Download subcomponent
-			if err := deploy.DownloadManifests(ctx, "odh-notebook-controller/odh-notebook-controller", subcomponent); err != nil {
-				return err
-			}
-			// If overlay is defined, update paths
-			defaultKustomizePathNbc := "base"
-			if subcomponent.SourcePath != "" {
-				defaultKustomizePathNbc = subcomponent.SourcePath
-			}
-			notebookControllerPath = filepath.Join(deploy.DefaultManifestPath, "odh-notebook-controller/odh-notebook-controller", defaultKustomizePathNbc)
-		}
+// resolveManifests downloads manifests set in devflags into the workspace, in place of the built-in ones.
+func (w *Workbenches) resolveManifests(ctx context.Context, cli client.Client, workspace *deploy.Workspace,
+	platform cluster.Platform,
+) (manifestsPaths, error) {
+	paths := manifestsPaths{}
+	var err error
+	if paths.notebookController, err = workspace.Resolve(ctx, cli, notebookControllerFolder, notebookControllerPath,
+		w.contextDirOverride("components/odh-notebook-controller")); err != nil {
+		return paths, err
+	}
+	if paths.kfNotebookController, err = workspace.Resolve(ctx, cli, kfnotebookControllerFolder, kfnotebookControllerPath,
+		w.contextDirOverride("components/notebook-controller")); err != nil {
+		return paths, err
+	}
+	if platform == cluster.ManagedRhods || platform == cluster.SelfManagedRhods {
+		paths.notebookImages, err = workspace.Resolve(ctx, cli, notebookImagesFolderSupported, notebookImagesPathSupported,
+			w.ManifestsOverride(DependentComponentName))
+	} else {
+		paths.notebookImages, err = workspace.Resolve(ctx, cli, DependentComponentName, notebookImagesPath,
+			w.ManifestsOverride(DependentComponentName))
+	}
 
-		if strings.Contains(subcomponent.ContextDir, "components/notebook-controller") {
-			// Download subcomponent
-			if err := deploy.DownloadManifests(ctx, "odh-notebook-controller/kf-notebook-controller", subcomponent); err != nil {
-				return err
-			}
-			// If overlay is defined, update paths
-			defaultKustomizePathKfNbc := "overlays/openshift"
-			if subcomponent.SourcePath != "" {
-				defaultKustomizePathKfNbc = subcomponent.SourcePath
-			}
-			kfnotebookControllerPath = filepath.Join(deploy.DefaultManifestPath, "odh-notebook-controller/kf-notebook-controller", defaultKustomizePathKfNbc)
+	return paths, err
+}
+
+// contextDirOverride returns the devflags manifests config whose contextDir contains the given directory, as the notebook
+// controllers are both built from the kubeflow repository.
+func (w *Workbenches) contextDirOverride(dir string) *components.ManifestsConfig {
+	if w.ManifestsOverride("") == nil {
+		return nil
+	}
+	for i := range w.DevFlags.Manifests {
+		if strings.Contains(w.DevFlags.Manifests[i].ContextDir, dir) {
+			return &w.DevFlags.Manifests[i]
 		}
 	}
+
 	return nil
 }
 
@@ -99,9 +120,16 @@ func (w *Workbenches) GetComponentName() string {
 func (w *Workbenches) ReconcileComponent(ctx context.Context, cli client.Client, logger logr.Logger,
 	owner metav1.Object, dscispec *dsciv1.DSCInitializationSpec, platform cluster.Platform, _ bool) error {
 	l := w.ConfigComponentLogger(logger, ComponentName, dscispec)
-	var imageParamMap = map[string]string{
-		"odh-notebook-controller-image":    "RELATED_IMAGE_ODH_NOTEBOOK_CONTROLLER_IMAGE",
-		"odh-kf-notebook-controller-image": "RELATED_IMAGE_ODH_KF_NOTEBOOK_CONTROLLER_IMAGE",
+	imageParamMap := registration.ImageParams
+
+	workspace, err := deploy.NewWorkspace(ComponentName)
+	if err != nil {
+		return err
+	}
+	defer workspace.Close()
+	paths, err := w.resolveManifests(ctx, cli, workspace, platform)
+	if err != nil {
+		return err
 	}
 
 	// Set default notebooks namespace
@@ -111,12 +139,6 @@ func (w *Workbenches) ReconcileComponent(ctx context.Context, cli client.Client,
 	// Set default notebooks namespace
 	// Create rhods-notebooks namespace in managed platforms
 	if enabled {
-		if w.DevFlags != nil {
-			// Download manifests and update paths
-			if err := w.OverrideManifests(ctx, string(platform)); err != nil {
-				return err
-			}
-		}
 		if platform == cluster.SelfManagedRhods || platform == cluster.ManagedRhods {
 			// Intentionally leaving the ownership unset for this namespace.
 			// Specifying this label triggers its deletion when the operator is uninstalled.
@@ -131,64 +153,54 @@ func (w *Workbenches) ReconcileComponent(ctx context.Context, cli client.Client,
 			return err
 		}
 	}
-	if err := deploy.DeployManifestsFromPath(ctx, cli, owner, notebookControllerPath, dscispec.ApplicationsNamespace, ComponentName, enabled); err != nil {
-		return fmt.Errorf("failed to apply manifetss %s: %w", notebookControllerPath, err)
+	if err := deploy.DeployManifestsFromPath(ctx, cli, owner, paths.notebookController, dscispec.ApplicationsNamespace, ComponentName, enabled); err != nil {
+		return fmt.Errorf("failed to apply manifetss %s: %w", paths.notebookController, err)
 	}
-	l.WithValues("Path", notebookControllerPath).Info("apply manifests done NBC")
+	l.WithValues("Path", paths.notebookController).Info("apply manifests done NBC")
 
 	// Update image parameters for nbc in downstream
 	if enabled {
 		if (dscispec.DevFlags == nil || dscispec.DevFlags.ManifestsUri == "") && (w.DevFlags == nil || len(w.DevFlags.Manifests) == 0) {
 			if platform == cluster.ManagedRhods || platform == cluster.SelfManagedRhods {
 				// for kf-notebook-controller image
-				if err := deploy.ApplyParams(notebookControllerPath, imageParamMap, false); err != nil {
-					return fmt.Errorf("failed to update image %s: %w", notebookControllerPath, err)
+				if err := deploy.ApplyParams(paths.notebookController, imageParamMap, false); err != nil {
+					return fmt.Errorf("failed to update image %s: %w", paths.notebookController, err)
 				}
 				// for odh-notebook-controller image
-				if err := deploy.ApplyParams(kfnotebookControllerPath, imageParamMap, false); err != nil {
-					return fmt.Errorf("failed to update image %s: %w", kfnotebookControllerPath, err)
+				if err := deploy.ApplyParams(paths.kfNotebookController, imageParamMap, false); err != nil {
+					return fmt.Errorf("failed to update image %s: %w", paths.kfNotebookController, err)
 				}
 			}
 		}
 	}
 
-	var manifestsPath string
 	if platform == cluster.OpenDataHub || platform == "" {
 		// only for ODH after transit to kubeflow repo
 		if err := deploy.DeployManifestsFromPath(ctx, cli, owner,
-			kfnotebookControllerPath,
+			paths.kfNotebookController,
 			dscispec.ApplicationsNamespace,
 			ComponentName, enabled); err != nil {
 			return err
 		}
-		manifestsPath = notebookImagesPath
-	} else {
-		manifestsPath = notebookImagesPathSupported
 	}
 	if err := deploy.DeployManifestsFromPath(ctx, cli, owner,
-		manifestsPath,
+		paths.notebookImages,
 		dscispec.ApplicationsNamespace,
 		ComponentName, enabled); err != nil {
 		return err
 	}
-	l.WithValues("Path", manifestsPath).Info("apply manifests done notebook image")
+	l.WithValues("Path", paths.notebookImages).Info("apply manifests done notebook image")
 	// CloudService Monitoring handling
 	if platform == cluster.ManagedRhods {
 		if enabled {
 			// first check if the service is up, so prometheus wont fire alerts when it is just startup
 			// only 1 replica set timeout to 1min
-			if err := cluster.WaitForDeploymentAvailable(ctx, cli, ComponentName, dscispec.ApplicationsNamespace, 10, 1); err != nil {
+			if err := cluster.CheckDeploymentAvailable(ctx, cli, ComponentName, dscispec.ApplicationsNamespace); err != nil {
 				return fmt.Errorf("deployments for %s are not ready to server: %w", ComponentName, err)
 			}
 			l.Info("deployment is done, updating monitoring rules")
 		}
-		if err := w.UpdatePrometheusConfig(cli, enabled && monitoringEnabled, ComponentName); err != nil {
-			return err
-		}
-		if err := deploy.DeployManifestsFromPath(ctx, cli, owner,
-			filepath.Join(deploy.DefaultManifestPath, "monitoring", "prometheus", "apps"),
-			dscispec.Monitoring.Namespace,
-			"prometheus", true); err != nil {
+		if err := monitoring.UpdatePrometheusConfig(ctx, cli, owner, ComponentName, enabled && monitoringEnabled); err != nil {
 			return err
 		}
 		l.Info("updating SRE monitoring done")
@@ -0,0 +1,98 @@
+package datasciencecluster
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/datasciencecluster/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/components"
+	"github.com/opendatahub-io/opendatahub-operator/v2/components/external"
+)
+
+//+kubebuilder:rbac:groups="datasciencecluster.opendatahub.io",resources=componentdefinitions,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:groups="datasciencecluster.opendatahub.io",resources=componentdefinitions/finalizers,verbs=update;patch
+
+// componentDefinitionFinalizer keeps a ComponentDefinition until its component is removed from all DataScienceClusters.
+const componentDefinitionFinalizer = "datasciencecluster.opendatahub.io/componentdefinition-finalizer"
+
+// externalComponents returns the components declared by the ComponentDefinitions, adding the finalizer to the ones which
+// are not being deleted. Definitions named after a registered component are ignored, as the webhook denies them.
+func (r *DataScienceClusterReconciler) externalComponents(ctx context.Context, instance *dscv1.DataScienceCluster,
+	definitions []dscv1.ComponentDefinition,
+) ([]components.ComponentInterface, error) {
+	var externalComponents []components.ComponentInterface
+	for i := range definitions {
+		definition := &definitions[i]
+		if _, registered := components.Lookup(definition.Name); registered {
+			r.Log.Info("ignoring ComponentDefinition named after a built-in component", "name", definition.Name)
+			r.Recorder.Eventf(instance, corev1.EventTypeWarning, "ComponentDefinitionIgnored",
+				"ComponentDefinition %s is ignored, as it is named after a built-in component", definition.Name)
+
+			continue
+		}
+		if definition.DeletionTimestamp.IsZero() && !controllerutil.ContainsFinalizer(definition, componentDefinitionFinalizer) {
+			patch := client.MergeFrom(definition.DeepCopy())
+			controllerutil.AddFinalizer(definition, componentDefinitionFinalizer)
+			if err := r.Client.Patch(ctx, definition, patch); err != nil {
+				return nil, err
+			}
+		}
+		externalComponents = append(externalComponents, external.New(definition))
+	}
+
+	return externalComponents, nil
+}
+
+// releaseComponentDefinitions removes the finalizer of the ComponentDefinitions being deleted whose component is not
+// installed anymore by any DataScienceCluster. The status of instance, when given, is more recent than the cached one.
+func (r *DataScienceClusterReconciler) releaseComponentDefinitions(ctx context.Context, definitions []dscv1.ComponentDefinition,
+	instance *dscv1.DataScienceCluster,
+) error {
+	dscs := &dscv1.DataScienceClusterList{}
+	if err := r.Client.List(ctx, dscs); err != nil {
+		return err
+	}
+
+	for i := range definitions {
+		definition := &definitions[i]
+		if definition.DeletionTimestamp.IsZero() || !controllerutil.ContainsFinalizer(definition, componentDefinitionFinalizer) {
+			continue
+		}
+		installed := false
+		for j := range dscs.Items {
+			dsc := &dscs.Items[j]
+			if instance != nil && dsc.Name == instance.Name {
+				dsc = instance
+			}
+			// components of deleted DataScienceClusters are garbage collected along with them
+			if dsc.DeletionTimestamp.IsZero() && dsc.Status.InstalledComponents[definition.Name] {
+				installed = true
+
+				break
+			}
+		}
+		if installed {
+			continue
+		}
+
+		r.Log.Info("Removing finalizer of ComponentDefinition", "name", definition.Name, "finalizer", componentDefinitionFinalizer)
+		patch := client.MergeFrom(definition.DeepCopy())
+		controllerutil.RemoveFinalizer(definition, componentDefinitionFinalizer)
+		if err := r.Client.Patch(ctx, definition, patch); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *DataScienceClusterReconciler) watchComponentDefinitions(ctx context.Context) func(client.Object) []reconcile.Request {
+	return func(_ client.Object) []reconcile.Request {
+		// Every DataScienceCluster deploys the external components
+		return r.getRequests(ctx)
+	}
+}
@@ -19,15 +19,13 @@ package datasciencecluster
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/hashicorp/go-multierror"
-	buildv1 "github.com/openshift/api/build/v1"
-	imagev1 "github.com/openshift/api/image/v1"
 	operatorv1 "github.com/openshift/api/operator/v1"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	appsv1 "k8s.io/api/apps/v1"
@@ -36,6 +34,7 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
@@ -52,10 +51,15 @@ import (
 
 	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/datasciencecluster/v1"
 	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/components"
 	"github.com/opendatahub-io/opendatahub-operator/v2/components/datasciencepipelines"
+	"github.com/opendatahub-io/opendatahub-operator/v2/components/external"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/featuretracker"
 	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+	ctrlogger "github.com/opendatahub-io/opendatahub-operator/v2/pkg/logger"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/upgrade"
 )
@@ -68,15 +72,28 @@ type DataScienceClusterReconciler struct {
 	// Recorder to generate events
 	Recorder           record.EventRecorder
 	DataScienceCluster *DataScienceClusterConfig
+	// MaxConcurrentComponentReconciles limits how many components are reconciled at the same time.
+	// Defaults to defaultMaxConcurrentComponentReconciles when not set.
+	MaxConcurrentComponentReconciles int
+	// ManifestsCache keeps the archives downloaded for devFlags manifests between reconciles.
+	// Archives are downloaded on every reconcile when not set.
+	ManifestsCache *deploy.ManifestsCache
+
+	// componentLoggers are the loggers of components setting their log level
+	componentLoggers ctrlogger.Loggers
 }
 
 // DataScienceClusterConfig passing Spec of DSCI for reconcile DataScienceCluster.
+// DSCISpec holds the defaults used until a DSCInitialization exists, it is not modified while reconciling: each
+// reconcile works on its own copy of the spec of the DSCInitialization, bound to the applications namespace of the instance.
 type DataScienceClusterConfig struct {
 	DSCISpec *dsciv1.DSCInitializationSpec
 }
 
 const (
 	finalizerName = "datasciencecluster.opendatahub.io/finalizer"
+
+	defaultMaxConcurrentComponentReconciles = 4
 )
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
@@ -96,6 +113,10 @@ func (r *DataScienceClusterReconciler) Reconcile(ctx context.Context, req ctrl.R
 	if err := r.Client.List(ctx, instances); err != nil {
 		return ctrl.Result{}, err
 	}
+	definitions := &dscv1.ComponentDefinitionList{}
+	if err := r.Client.List(ctx, definitions); err != nil {
+		return ctrl.Result{}, err
+	}
 
 	if len(instances.Items) == 0 {
 		// Request object not found, could have been deleted after reconcile request.
@@ -104,19 +125,41 @@ func (r *DataScienceClusterReconciler) Reconcile(ctx context.Context, req ctrl.R
 		// Return and don't requeue
 		if upgrade.HasDeleteConfigMap(ctx, r.Client) {
 			if uninstallErr := upgrade.OperatorUninstall(ctx, r.Client); uninstallErr != nil {
+				if notReady, waiting := cluster.AsNotReady(uninstallErr); waiting {
+					r.Log.Info("operator uninstall in progress", "reason", notReady.Message)
+
+					return ctrl.Result{RequeueAfter: notReady.RequeueAfter}, nil
+				}
+
 				return ctrl.Result{}, fmt.Errorf("error while operator uninstall: %w", uninstallErr)
 			}
 		}
 
-		return ctrl.Result{}, nil
+		return ctrl.Result{}, r.releaseComponentDefinitions(ctx, definitions.Items, nil)
 	}
 
-	instance := &instances.Items[0]
+	// Several DataScienceClusters can exist, each deploying components to its own applications namespace
+	var instance *dscv1.DataScienceCluster
+	for i := range instances.Items {
+		if instances.Items[i].Name == req.Name {
+			instance = &instances.Items[i]
+		}
+	}
+	if instance == nil {
+		return ctrl.Result{}, r.releaseComponentDefinitions(ctx, definitions.Items, nil)
+	}
 
 	allComponents, err := instance.GetComponents()
 	if err != nil {
 		return ctrl.Result{}, err
 	}
+	// External components declared with ComponentDefinitions are reconciled along with the built-in ones
+	externalComponents, err := r.externalComponents(ctx, instance, definitions.Items)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	allComponents = append(allComponents, externalComponents...)
+	dscispec := *r.DataScienceCluster.DSCISpec.DeepCopy()
 
 	// If DSC CR exist and deletion CM exist
 	// delete DSC CR and let reconcile requeue
@@ -137,7 +180,7 @@ func (r *DataScienceClusterReconciler) Reconcile(ctx context.Context, req ctrl.R
 			}
 		}
 		for _, component := range allComponents {
-			if err := component.Cleanup(ctx, r.Client, r.DataScienceCluster.DSCISpec); err != nil {
+			if err := component.Cleanup(ctx, r.Client, &dscispec); err != nil {
 				return ctrl.Result{}, err
 			}
 		}
@@ -173,8 +216,9 @@ func (r *DataScienceClusterReconciler) Reconcile(ctx context.Context, req ctrl.R
 		}
 		return ctrl.Result{}, nil
 	case 1:
-		dscInitializationSpec := dsciInstances.Items[0].Spec
-		dscInitializationSpec.DeepCopyInto(r.DataScienceCluster.DSCISpec)
+		dscispec = *dsciInstances.Items[0].Spec.DeepCopy()
+		// components are deployed to the applications namespace of the instance
+		dscispec.ApplicationsNamespace = instance.GetApplicationsNamespace(dsciInstances.Items[0].Spec.ApplicationsNamespace)
 	}
 
 	if instance.ObjectMeta.DeletionTimestamp.IsZero() {
@@ -188,7 +232,7 @@ func (r *DataScienceClusterReconciler) Reconcile(ctx context.Context, req ctrl.R
 	} else {
 		r.Log.Info("Finalization DataScienceCluster start deleting instance", "name", instance.Name, "finalizer", finalizerName)
 		for _, component := range allComponents {
-			if err := component.Cleanup(ctx, r.Client, r.DataScienceCluster.DSCISpec); err != nil {
+			if err := component.Cleanup(ctx, r.Client, &dscispec); err != nil {
 				return ctrl.Result{}, err
 			}
 		}
@@ -205,6 +249,26 @@ func (r *DataScienceClusterReconciler) Reconcile(ctx context.Context, req ctrl.R
 
 		return ctrl.Result{}, nil
 	}
+	// The applications namespace of the instance is created by the DSCInitialization
+	if err := r.Client.Get(ctx, client.ObjectKey{Name: dscispec.ApplicationsNamespace}, &corev1.Namespace{}); err != nil {
+		if !k8serr.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+		message := fmt.Sprintf("Waiting for applications namespace %s to be created", dscispec.ApplicationsNamespace)
+		r.Log.Info(message)
+		_, err = status.UpdateWithRetry(ctx, r.Client, instance, func(saved *dscv1.DataScienceCluster) {
+			status.SetProgressingCondition(&saved.Status.Conditions, status.ReconcileInit, message)
+			saved.Status.Phase = status.PhaseProgressing
+		})
+
+		return ctrl.Result{RequeueAfter: 10 * time.Second}, err
+	}
+
+	// Only compute changes without applying them when plan mode is requested
+	if isPlanOnly(instance) {
+		return r.reconcilePlan(ctx, instance, dscispec, allComponents)
+	}
+
 	// Check preconditions if this is an upgrade
 	if instance.Status.Phase == status.PhaseReady {
 		// Check for existence of Argo Workflows if DSP is
@@ -236,13 +300,29 @@ func (r *DataScienceClusterReconciler) Reconcile(ctx context.Context, req ctrl.R
 		}
 	}
 
-	// Initialize error list, instead of returning errors after every component is deployed
-	var componentErrors *multierror.Error
+	// Reconcile components in dependency order, collecting errors instead of returning after every failed component
+	componentErrors := r.reconcileComponents(ctx, instance, dscispec, allComponents)
 
-	for _, component := range allComponents {
-		if instance, err = r.reconcileSubComponent(ctx, instance, component); err != nil {
-			componentErrors = multierror.Append(componentErrors, err)
+	// Components waiting for the cluster are resumed later, instead of blocking the reconciliation
+	if notReady, waiting := cluster.AsNotReady(componentErrors.ErrorOrNil()); waiting {
+		message := "Waiting for components: " + notReady.Message
+		r.Log.Info(message)
+		instance, err = status.UpdateWithRetry(ctx, r.Client, instance, func(saved *dscv1.DataScienceCluster) {
+			status.SetProgressingCondition(&saved.Status.Conditions, status.Waiting, message)
+			clearPlan(&saved.Status)
+			pruneComponentStatus(&saved.Status, allComponents)
+			saved.Status.Phase = status.PhaseProgressing
+		})
+		if err != nil {
+			r.Log.Error(err, "failed to update DataScienceCluster conditions while waiting for components")
+
+			return ctrl.Result{}, err
 		}
+		if err := r.releaseComponentDefinitions(ctx, definitions.Items, instance); err != nil {
+			return ctrl.Result{}, err
+		}
+
+		return ctrl.Result{RequeueAfter: notReady.RequeueAfter}, nil
 	}
 
 	// Process errors for components
@@ -251,6 +331,8 @@ func (r *DataScienceClusterReconciler) Reconcile(ctx context.Context, req ctrl.R
 		instance, err = status.UpdateWithRetry(ctx, r.Client, instance, func(saved *dscv1.DataScienceCluster) {
 			status.SetCompleteCondition(&saved.Status.Conditions, status.ReconcileCompletedWithComponentErrors,
 				fmt.Sprintf("DataScienceCluster resource reconciled with component errors: %v", componentErrors))
+			clearPlan(&saved.Status)
+			pruneComponentStatus(&saved.Status, allComponents)
 			saved.Status.Phase = status.PhaseReady
 		})
 		if err != nil {
@@ -260,6 +342,9 @@ func (r *DataScienceClusterReconciler) Reconcile(ctx context.Context, req ctrl.R
 		}
 		r.Recorder.Eventf(instance, corev1.EventTypeNormal, "DataScienceClusterComponentFailures",
 			"DataScienceCluster instance %s created, but have some failures in component %v", instance.Name, componentErrors)
+		if err := r.releaseComponentDefinitions(ctx, definitions.Items, instance); err != nil {
+			return ctrl.Result{}, err
+		}
 
 		return ctrl.Result{RequeueAfter: time.Second * 30}, componentErrors
 	}
@@ -267,6 +352,8 @@ func (r *DataScienceClusterReconciler) Reconcile(ctx context.Context, req ctrl.R
 	// finalize reconciliation
 	instance, err = status.UpdateWithRetry(ctx, r.Client, instance, func(saved *dscv1.DataScienceCluster) {
 		status.SetCompleteCondition(&saved.Status.Conditions, status.ReconcileCompleted, "DataScienceCluster resource reconciled successfully")
+		clearPlan(&saved.Status)
+		pruneComponentStatus(&saved.Status, allComponents)
 		saved.Status.Phase = status.PhaseReady
 		saved.Status.Release = currentOperatorReleaseVersion
 	})
@@ -277,6 +364,10 @@ func (r *DataScienceClusterReconciler) Reconcile(ctx context.Context, req ctrl.R
 		return ctrl.Result{}, err
 	}
 
+	if err := r.releaseComponentDefinitions(ctx, definitions.Items, instance); err != nil {
+		return ctrl.Result{}, err
+	}
+
 	r.Log.Info("DataScienceCluster Deployment Completed.")
 	r.Recorder.Eventf(instance, corev1.EventTypeNormal, "DataScienceClusterCreationSuccessful",
 		"DataScienceCluster instance %s created and deployed successfully", instance.Name)
@@ -284,8 +375,89 @@ func (r *DataScienceClusterReconciler) Reconcile(ctx context.Context, req ctrl.R
 	return ctrl.Result{}, nil
 }
 
+// reconcileComponents reconciles the given components level by level, as computed by components.ReconcileOrder.
+// Components of the same level are reconciled concurrently, bounded by MaxConcurrentComponentReconciles.
+// Components whose dependencies failed are skipped and reported as such in their conditions.
+// Each component merges its own status through status.UpdateWithRetry, therefore the instance is only read here.
+func (r *DataScienceClusterReconciler) reconcileComponents(ctx context.Context, instance *dscv1.DataScienceCluster,
+	dscispec dsciv1.DSCInitializationSpec, allComponents []components.ComponentInterface,
+) *multierror.Error {
+	var componentErrors *multierror.Error
+
+	levels, err := components.ReconcileOrder(allComponents)
+	if err != nil {
+		return multierror.Append(componentErrors, err)
+	}
+
+	maxConcurrent := r.MaxConcurrentComponentReconciles
+	if maxConcurrent <= 0 {
+		maxConcurrent = defaultMaxConcurrentComponentReconciles
+	}
+
+	var mu sync.Mutex
+	failed := make(map[string]bool)
+	for _, level := range levels {
+		var wg sync.WaitGroup
+		semaphore := make(chan struct{}, maxConcurrent)
+		for _, component := range level {
+			mu.Lock()
+			failedDependency := firstFailed(component.GetDependencies(), failed)
+			mu.Unlock()
+			if failedDependency != "" {
+				err := fmt.Errorf("component %s skipped as its dependency %s failed", component.GetComponentName(), failedDependency)
+				r.reportSkippedComponent(ctx, instance, component.GetComponentName(), err)
+				mu.Lock()
+				failed[component.GetComponentName()] = true
+				componentErrors = multierror.Append(componentErrors, err)
+				mu.Unlock()
+
+				continue
+			}
+
+			wg.Add(1)
+			semaphore <- struct{}{}
+			go func(component components.ComponentInterface) {
+				defer func() {
+					<-semaphore
+					wg.Done()
+				}()
+				if _, err := r.reconcileSubComponent(ctx, instance, dscispec, component); err != nil {
+					mu.Lock()
+					defer mu.Unlock()
+					// components waiting for the cluster have been deployed, their dependents are reconciled
+					failed[component.GetComponentName()] = !cluster.IsNotReady(err)
+					componentErrors = multierror.Append(componentErrors, err)
+				}
+			}(component)
+		}
+		wg.Wait()
+	}
+
+	return componentErrors
+}
+
+func firstFailed(dependencies []string, failed map[string]bool) string {
+	for _, dependency := range dependencies {
+		if failed[dependency] {
+			return dependency
+		}
+	}
+
+	return ""
+}
+
+func (r *DataScienceClusterReconciler) reportSkippedComponent(ctx context.Context, instance *dscv1.DataScienceCluster, componentName string, err error) {
+	r.reportError(err, instance, "skipped reconciling "+componentName+" on DataScienceCluster")
+	_, updateErr := status.UpdateWithRetry(ctx, r.Client, instance, func(saved *dscv1.DataScienceCluster) {
+		status.SetComponentCondition(&saved.Status.Conditions, componentName, status.DependencyFailed, err.Error(), corev1.ConditionFalse)
+	})
+	if updateErr != nil {
+		r.reportError(updateErr, instance, "failed to update DataScienceCluster conditions of skipped component "+componentName)
+	}
+}
+
 func (r *DataScienceClusterReconciler) reconcileSubComponent(ctx context.Context, instance *dscv1.DataScienceCluster,
-	component components.ComponentInterface,
+	dscispec dsciv1.DSCInitializationSpec, component components.ComponentInterface,
 ) (*dscv1.DataScienceCluster, error) {
 	componentName := component.GetComponentName()
 
@@ -314,12 +486,41 @@ func (r *DataScienceClusterReconciler) reconcileSubComponent(ctx context.Context
 		r.Log.Error(err, "Failed to determine platform")
 		return instance, err
 	}
-	err = component.ReconcileComponent(ctx, r.Client, r.Log, instance, r.DataScienceCluster.DSCISpec, platform, installedComponentValue)
+	inventory := deploy.NewInventory()
+	conflicts := cluster.NewConflicts()
+	componentCtx := cluster.WithConflicts(deploy.WithInventory(ctx, inventory), conflicts)
+	componentCtx = deploy.WithManifestsCache(componentCtx, r.ManifestsCache)
+	componentCtx = deploy.WithPreservedFields(componentCtx, dscispec.PreservedFields, component.GetPreservedFields())
+	// Workload configuration set with the v2 API
+	componentConfig, err := instance.ComponentConfig(componentName)
+	if err != nil {
+		return instance, err
+	}
+	componentCtx = deploy.WithComponentConfig(componentCtx, componentConfig)
+	logger := r.Log
+	if componentConfig != nil && componentConfig.LogLevel != "" {
+		logger = r.componentLoggers.ForMode(componentConfig.LogLevel)
+	}
+	err = component.ReconcileComponent(componentCtx, r.Client, logger, instance, &dscispec, platform, installedComponentValue)
+	componentStatus := newComponentStatus(instance, component, inventory, err)
 
+	if notReady, waiting := cluster.AsNotReady(err); waiting {
+		// the component is resumed later, reporting what it waits for
+		r.Log.Info("component is waiting", "component", componentName, "reason", notReady.Message)
+		instance, _ = status.UpdateWithRetry(ctx, r.Client, instance, func(saved *dscv1.DataScienceCluster) {
+			setComponentStatus(&saved.Status, componentName, componentStatus)
+			setFieldConflictCondition(&saved.Status, componentName, conflicts)
+			status.SetComponentCondition(&saved.Status.Conditions, componentName, status.Waiting, "Component is waiting: "+notReady.Message, corev1.ConditionFalse)
+		})
+
+		return instance, err
+	}
 	if err != nil {
 		// reconciliation failed: log errors, raise event and update status accordingly
 		instance = r.reportError(err, instance, "failed to reconcile "+componentName+" on DataScienceCluster")
 		instance, _ = status.UpdateWithRetry(ctx, r.Client, instance, func(saved *dscv1.DataScienceCluster) {
+			setComponentStatus(&saved.Status, componentName, componentStatus)
+			setFieldConflictCondition(&saved.Status, componentName, conflicts)
 			if enabled {
 				if strings.Contains(err.Error(), datasciencepipelines.ArgoWorkflowCRD+" CRD already exists") {
 					datasciencepipelines.SetExistingArgoCondition(&saved.Status.Conditions, status.ArgoWorkflowExist, fmt.Sprintf("Component update failed: %v", err))
@@ -332,16 +533,29 @@ func (r *DataScienceClusterReconciler) reconcileSubComponent(ctx context.Context
 		})
 		return instance, err
 	}
-	// reconciliation succeeded: update status accordingly
+	if previous, found := instance.Status.Components[componentName]; found && enabled &&
+		previous.ManifestsSource.Type == dscv1.ManifestsSourceDevFlags && componentStatus.ManifestsSource.Type == dscv1.ManifestsSourceBuiltIn {
+		r.Log.Info("component reverted to built-in manifests", "component", componentName)
+		r.Recorder.Eventf(instance, corev1.EventTypeNormal, "ManifestsReverted",
+			"Component %s is deployed from the built-in manifests, as its DevFlags manifests have been removed", componentName)
+	}
+	// reconciliation succeeded: the component is ready once its workloads are, which the readiness controller follows
+	if enabled {
+		componentStatus.Workloads = workloadStatuses(ctx, r.Client, readinessWorkloads(component, inventory, &dscispec))
+	}
 	instance, err = status.UpdateWithRetry(ctx, r.Client, instance, func(saved *dscv1.DataScienceCluster) {
 		if saved.Status.InstalledComponents == nil {
 			saved.Status.InstalledComponents = make(map[string]bool)
 		}
 		saved.Status.InstalledComponents[componentName] = enabled
 		if enabled {
-			status.SetComponentCondition(&saved.Status.Conditions, componentName, status.ReconcileCompleted, "Component reconciled successfully", corev1.ConditionTrue)
+			setComponentStatus(&saved.Status, componentName, componentStatus)
+			setFieldConflictCondition(&saved.Status, componentName, conflicts)
+			setReadinessCondition(&saved.Status.Conditions, componentName, componentStatus.Workloads)
 		} else {
+			delete(saved.Status.Components, componentName)
 			status.RemoveComponentCondition(&saved.Status.Conditions, componentName)
+			status.RemoveComponentFieldConflictCondition(&saved.Status.Conditions, componentName)
 		}
 	})
 	if err != nil {
@@ -353,6 +567,79 @@ func (r *DataScienceClusterReconciler) reconcileSubComponent(ctx context.Context
 	return instance, nil
 }
 
+// setFieldConflictCondition reports fields of the component objects which have been changed by other field managers
+// during the last reconciliation, or clears the condition when there were none.
+func setFieldConflictCondition(dscStatus *dscv1.DataScienceClusterStatus, componentName string, conflicts *cluster.Conflicts) {
+	list := conflicts.List()
+	if len(list) == 0 {
+		status.RemoveComponentFieldConflictCondition(&dscStatus.Conditions, componentName)
+
+		return
+	}
+
+	messages := make([]string, 0, len(list))
+	for _, conflict := range list {
+		messages = append(messages, conflict.String())
+	}
+	status.SetComponentFieldConflictCondition(&dscStatus.Conditions, componentName, strings.Join(messages, "; "))
+}
+
+// newComponentStatus describes the outcome of reconciling the given component, based on what has been recorded in the inventory.
+func newComponentStatus(instance *dscv1.DataScienceCluster, component components.ComponentInterface, inventory *deploy.Inventory,
+	reconcileErr error,
+) dscv1.ComponentStatus {
+	now := metav1.Now()
+	componentStatus := dscv1.ComponentStatus{
+		ObservedGeneration: instance.Generation,
+		ManifestsSource:    dscv1.ManifestsSource{Type: dscv1.ManifestsSourceBuiltIn},
+		Images:             inventory.Images(),
+		LastReconcileTime:  &now,
+		Objects:            inventory.Objects(),
+	}
+
+	// Sources are the manifests actually downloaded into the workspace, built-in manifests are back in use as soon as
+	// DevFlags are removed
+	if sources := inventory.ManifestsSources(); len(sources) != 0 {
+		componentStatus.ManifestsSource.Type = dscv1.ManifestsSourceDevFlags
+		componentStatus.ManifestsSource.URIs = sources
+	} else if externalComponent, ok := component.(*external.External); ok {
+		componentStatus.ManifestsSource.Type = dscv1.ManifestsSourceComponentDefinition
+		componentStatus.ManifestsSource.URIs = []string{externalComponent.Definition.Spec.Manifests.URI}
+	}
+
+	if reconcileErr != nil && !cluster.IsNotReady(reconcileErr) {
+		componentStatus.LastError = reconcileErr.Error()
+	}
+
+	return componentStatus
+}
+
+func setComponentStatus(dscStatus *dscv1.DataScienceClusterStatus, componentName string, componentStatus dscv1.ComponentStatus) {
+	if dscStatus.Components == nil {
+		dscStatus.Components = make(map[string]dscv1.ComponentStatus)
+	}
+	dscStatus.Components[componentName] = componentStatus
+}
+
+// pruneComponentStatus removes the status of components which do not exist anymore, e.g. after an upgrade of the
+// operator dropping one of them, or once the ComponentDefinition of an external component is deleted.
+func pruneComponentStatus(dscStatus *dscv1.DataScienceClusterStatus, allComponents []components.ComponentInterface) {
+	known := make(map[string]bool, len(allComponents))
+	for _, component := range allComponents {
+		known[component.GetComponentName()] = true
+	}
+	for componentName := range dscStatus.InstalledComponents {
+		if !known[componentName] {
+			delete(dscStatus.InstalledComponents, componentName)
+		}
+	}
+	for componentName := range dscStatus.Components {
+		if !known[componentName] {
+			delete(dscStatus.Components, componentName)
+		}
+	}
+}
+
 func (r *DataScienceClusterReconciler) reportError(err error, instance *dscv1.DataScienceCluster, message string) *dscv1.DataScienceCluster {
 	r.Log.Error(err, message, "instance.Name", instance.Name)
 	r.Recorder.Eventf(instance, corev1.EventTypeWarning, "DataScienceClusterReconcileError",
@@ -434,7 +721,7 @@ var modelMeshGeneralPredicates = predicate.Funcs{
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *DataScienceClusterReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&dscv1.DataScienceCluster{}).
 		Owns(&corev1.Namespace{}).
 		Owns(&corev1.Secret{}).
@@ -448,34 +735,52 @@ func (r *DataScienceClusterReconciler) SetupWithManager(ctx context.Context, mgr
 		Owns(&corev1.PersistentVolumeClaim{}).
 		Owns(&corev1.Service{}, builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, modelMeshGeneralPredicates))).
 		Owns(&appsv1.StatefulSet{}).
-		Owns(&imagev1.ImageStream{}).
-		Owns(&buildv1.BuildConfig{}).
 		Owns(&apiregistrationv1.APIService{}).
 		Owns(&networkingv1.Ingress{}).
 		Owns(&admissionregistrationv1.MutatingWebhookConfiguration{}).
 		Owns(&admissionregistrationv1.ValidatingWebhookConfiguration{}, builder.WithPredicates(modelMeshwebhookPredicates)).
-		Owns(&corev1.ServiceAccount{}, builder.WithPredicates(saPredicates)).
+		Owns(&corev1.ServiceAccount{}, builder.WithPredicates(saPredicates))
+	// readiness of components follows the health of their workloads, including status changes filtered out below
+	err := ctrl.NewControllerManagedBy(mgr).
+		Named("datasciencecluster-readiness").
+		For(&dscv1.DataScienceCluster{}).
+		Watches(&source.Kind{Type: &appsv1.Deployment{}}, &handler.EnqueueRequestForOwner{OwnerType: &dscv1.DataScienceCluster{}}).
+		Watches(&source.Kind{Type: &appsv1.StatefulSet{}}, &handler.EnqueueRequestForOwner{OwnerType: &dscv1.DataScienceCluster{}}).
+		Complete(&readinessReconciler{Client: r.Client, Log: r.Log.WithName("readiness")})
+	if err != nil {
+		return err
+	}
+
+	// types owned by some components only are declared in their registration
+	owned := map[string]bool{}
+	for _, registration := range components.Registrations() {
+		for _, obj := range registration.OwnedTypes {
+			if kind := fmt.Sprintf("%T", obj); !owned[kind] {
+				owned[kind] = true
+				b = b.Owns(obj)
+			}
+		}
+	}
+
+	return b.
 		Watches(&source.Kind{Type: &dsciv1.DSCInitialization{}}, handler.EnqueueRequestsFromMapFunc(r.watchDataScienceClusterForDSCI(ctx))).
 		Watches(&source.Kind{Type: &corev1.ConfigMap{}}, handler.EnqueueRequestsFromMapFunc(r.watchDataScienceClusterResources(ctx)), builder.WithPredicates(configMapPredicates)).
 		Watches(&source.Kind{Type: &apiextensionsv1.CustomResourceDefinition{}}, handler.EnqueueRequestsFromMapFunc(r.watchDataScienceClusterResources(ctx)),
 			builder.WithPredicates(argoWorkflowCRDPredicates)).
 		Watches(&source.Kind{Type: &corev1.Secret{}}, handler.EnqueueRequestsFromMapFunc(r.watchDefaultIngressSecret(ctx)), builder.WithPredicates(defaultIngressCertSecretPredicates)).
+		Watches(&source.Kind{Type: &dscv1.ComponentDefinition{}}, handler.EnqueueRequestsFromMapFunc(r.watchComponentDefinitions(ctx))).
+		Watches(&source.Kind{Type: &featurev1.FeatureTracker{}}, handler.EnqueueRequestsFromMapFunc(r.watchFeatureTrackers(ctx))).
 		// this predicates prevents meaningless reconciliations from being triggered
-		WithEventFilter(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.LabelChangedPredicate{})).
+		WithEventFilter(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.LabelChangedPredicate{}, planOnlyChangedPredicate,
+			featuretracker.ReapplyPredicate)).
 		Complete(r)
 }
 
 func (r *DataScienceClusterReconciler) watchDataScienceClusterForDSCI(ctx context.Context) func(client.Object) []reconcile.Request {
 	return func(a client.Object) []reconcile.Request {
-		requestName, err := r.getRequestName(ctx)
-		if err != nil {
-			return nil
-		}
 		// When DSCI CR gets created, trigger reconcile function
 		if a.GetObjectKind().GroupVersionKind().Kind == "DSCInitialization" || a.GetName() == "default-dsci" {
-			return []reconcile.Request{{
-				NamespacedName: types.NamespacedName{Name: requestName},
-			}}
+			return r.getRequests(ctx)
 		}
 		return nil
 	}
@@ -483,15 +788,8 @@ func (r *DataScienceClusterReconciler) watchDataScienceClusterForDSCI(ctx contex
 
 func (r *DataScienceClusterReconciler) watchDataScienceClusterResources(ctx context.Context) func(client.Object) []reconcile.Request {
 	return func(a client.Object) []reconcile.Request {
-		requestName, err := r.getRequestName(ctx)
-		if err != nil {
-			return nil
-		}
-
 		if a.GetObjectKind().GroupVersionKind().Kind == "CustomResourceDefinition" || a.GetName() == "ArgoWorkflowCRD" {
-			return []reconcile.Request{{
-				NamespacedName: types.NamespacedName{Name: requestName},
-			}}
+			return r.getRequests(ctx)
 		}
 
 		// Trigger reconcile function when uninstall configmap is created
@@ -502,30 +800,40 @@ func (r *DataScienceClusterReconciler) watchDataScienceClusterResources(ctx cont
 		if a.GetNamespace() == operatorNs {
 			cmLabels := a.GetLabels()
 			if val, ok := cmLabels[upgrade.DeleteConfigMapLabel]; ok && val == "true" {
-				return []reconcile.Request{{
-					NamespacedName: types.NamespacedName{Name: requestName},
-				}}
+				return r.getRequests(ctx)
 			}
 		}
 		return nil
 	}
 }
 
-func (r *DataScienceClusterReconciler) getRequestName(ctx context.Context) (string, error) {
+// watchFeatureTrackers applies the features of components again when objects of one of them have been changed.
+func (r *DataScienceClusterReconciler) watchFeatureTrackers(ctx context.Context) func(client.Object) []reconcile.Request {
+	return func(a client.Object) []reconcile.Request {
+		// the event filter also lets created FeatureTrackers through, whose features have just been applied
+		if tracker, ok := a.(*featurev1.FeatureTracker); ok && tracker.Spec.Source.Type == featurev1.ComponentType && featuretracker.IsReapplying(tracker) {
+			return r.getRequests(ctx)
+		}
+		return nil
+	}
+}
+
+// getRequests returns a request for each DataScienceCluster, or for the default one when there is none yet.
+func (r *DataScienceClusterReconciler) getRequests(ctx context.Context) []reconcile.Request {
 	instanceList := &dscv1.DataScienceClusterList{}
-	err := r.Client.List(ctx, instanceList)
-	if err != nil {
-		return "", err
+	if err := r.Client.List(ctx, instanceList); err != nil {
+		return nil
 	}
 
-	switch {
-	case len(instanceList.Items) == 1:
-		return instanceList.Items[0].Name, nil
-	case len(instanceList.Items) == 0:
-		return "default-dsc", nil
-	default:
-		return "", errors.New("multiple DataScienceCluster instances found")
+	if len(instanceList.Items) == 0 {
+		return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: "default-dsc"}}}
+	}
+	requests := make([]reconcile.Request, 0, len(instanceList.Items))
+	for i := range instanceList.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: instanceList.Items[i].Name}})
 	}
+
+	return requests
 }
 
 // argoWorkflowCRDPredicates filters the delete events to trigger reconcile when Argo Workflow CRD is deleted.
@@ -545,10 +853,6 @@ var argoWorkflowCRDPredicates = predicate.Funcs{
 
 func (r *DataScienceClusterReconciler) watchDefaultIngressSecret(ctx context.Context) func(client.Object) []reconcile.Request {
 	return func(a client.Object) []reconcile.Request {
-		requestName, err := r.getRequestName(ctx)
-		if err != nil {
-			return nil
-		}
 		// When ingress secret gets created/deleted, trigger reconcile function
 		ingressCtrl, err := cluster.FindAvailableIngressController(ctx, r.Client)
 		if err != nil {
@@ -556,9 +860,7 @@ func (r *DataScienceClusterReconciler) watchDefaultIngressSecret(ctx context.Con
 		}
 		defaultIngressSecretName := cluster.GetDefaultIngressCertSecretName(ingressCtrl)
 		if a.GetName() == defaultIngressSecretName && a.GetNamespace() == "openshift-ingress" {
-			return []reconcile.Request{{
-				NamespacedName: types.NamespacedName{Name: requestName},
-			}}
+			return r.getRequests(ctx)
 		}
 		return nil
 	}
@@ -0,0 +1,137 @@
+package datasciencecluster
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/datasciencecluster/v1"
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/components"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+)
+
+const (
+	// ConditionPlanReady is set while the DataScienceCluster is reconciled in plan mode.
+	ConditionPlanReady = "PlanReady"
+
+	planComputed       = "PlanComputed"
+	planComputedErrors = "PlanComputedWithErrors"
+)
+
+func isPlanOnly(instance *dscv1.DataScienceCluster) bool {
+	return instance.GetAnnotations()[annotations.PlanOnly] == "true"
+}
+
+// reconcilePlan runs all components against a dry-run client and reports the changes they would perform in the status.
+// Components are processed one by one in dependency order, as they would be when reconciled for real. Steps waiting for
+// the cluster are reported as informational changes instead of errors.
+// Manifests are rendered in workspaces and DevFlags manifests are downloaded without being cached, so computing a plan
+// leaves the manifests used by the next reconcile unchanged.
+func (r *DataScienceClusterReconciler) reconcilePlan(ctx context.Context, instance *dscv1.DataScienceCluster,
+	dscispec dsciv1.DSCInitializationSpec, allComponents []components.ComponentInterface,
+) (ctrl.Result, error) {
+	r.Log.Info("Computing plan for DataScienceCluster", "name", instance.Name)
+
+	platform, err := cluster.GetPlatform(ctx, r.Client)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	levels, err := components.ReconcileOrder(allComponents)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	plan := deploy.NewPlan()
+	planCtx := deploy.WithPlan(ctx, plan)
+	dryRunClient := client.NewDryRunClient(r.Client)
+
+	var planErrors *multierror.Error
+	for _, level := range levels {
+		for _, component := range level {
+			componentName := component.GetComponentName()
+			installed := instance.Status.InstalledComponents[componentName]
+			componentCtx := deploy.WithPreservedFields(planCtx, dscispec.PreservedFields, component.GetPreservedFields())
+			err := component.ReconcileComponent(componentCtx, dryRunClient, r.Log, instance, &dscispec, platform, installed)
+			if err != nil && !plan.RecordNotReady(componentName, err) {
+				planErrors = multierror.Append(planErrors, fmt.Errorf("failed computing plan for %s: %w", componentName, err))
+			}
+		}
+	}
+
+	planStatus := newPlanStatus(instance, plan, planErrors)
+	_, err = status.UpdateWithRetry(ctx, r.Client, instance, func(saved *dscv1.DataScienceCluster) {
+		saved.Status.Plan = planStatus
+		if planErrors != nil {
+			status.SetCondition(&saved.Status.Conditions, ConditionPlanReady, planComputedErrors,
+				fmt.Sprintf("Plan computed with %d changes and errors: %v", len(planStatus.Changes), planErrors), corev1.ConditionFalse)
+		} else {
+			status.SetCondition(&saved.Status.Conditions, ConditionPlanReady, planComputed,
+				fmt.Sprintf("Plan computed with %d changes", len(planStatus.Changes)), corev1.ConditionTrue)
+		}
+	})
+	if err != nil {
+		r.reportError(err, instance, "failed to update DataScienceCluster status with computed plan")
+
+		return ctrl.Result{}, err
+	}
+
+	r.Recorder.Eventf(instance, corev1.EventTypeNormal, "DataScienceClusterPlanComputed",
+		"Plan for DataScienceCluster instance %s computed with %d changes", instance.Name, len(planStatus.Changes))
+
+	return ctrl.Result{}, nil
+}
+
+func newPlanStatus(instance *dscv1.DataScienceCluster, plan *deploy.Plan, planErrors *multierror.Error) *dscv1.Plan {
+	planStatus := &dscv1.Plan{
+		ObservedGeneration: instance.Generation,
+		GeneratedAt:        metav1.Now(),
+	}
+
+	for _, change := range plan.Changes() {
+		planStatus.Changes = append(planStatus.Changes, dscv1.PlannedChange{
+			Action:    string(change.Action),
+			Component: change.Component,
+			Object:    change.Object,
+			Fields:    change.Fields,
+			Message:   change.Message,
+		})
+	}
+
+	if planErrors != nil {
+		for _, err := range planErrors.Errors {
+			planStatus.Errors = append(planStatus.Errors, err.Error())
+		}
+	}
+
+	return planStatus
+}
+
+// clearPlan removes the plan computed before plan mode has been turned off.
+func clearPlan(dscStatus *dscv1.DataScienceClusterStatus) {
+	dscStatus.Plan = nil
+	conditionsv1.RemoveStatusCondition(&dscStatus.Conditions, ConditionPlanReady)
+}
+
+// planOnlyChangedPredicate triggers reconciliation when plan mode is turned on or off for a DataScienceCluster.
+var planOnlyChangedPredicate = predicate.Funcs{
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		if _, isDSC := e.ObjectNew.(*dscv1.DataScienceCluster); !isDSC {
+			return false
+		}
+
+		return e.ObjectOld.GetAnnotations()[annotations.PlanOnly] != e.ObjectNew.GetAnnotations()[annotations.PlanOnly]
+	},
+}
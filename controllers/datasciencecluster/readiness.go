@@ -0,0 +1,276 @@
+package datasciencecluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/datasciencecluster/v1"
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/components"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+)
+
+// Components are ready once the Deployments and StatefulSets they deployed are healthy, rather than as soon as their
+// manifests are applied. The DataScienceCluster controller evaluates them after reconciling a component, then the
+// readiness controller follows their changes through watches, without blocking any reconcile.
+
+// readinessRequeueAfter is the delay after which components which are not ready are evaluated again, for workloads
+// which are not owned by the DataScienceCluster, and for pods starting to crash loop.
+const readinessRequeueAfter = 30 * time.Second
+
+// readinessReconciler updates the readiness of the components of a DataScienceCluster as the health of their workloads
+// changes, without reconciling the components.
+type readinessReconciler struct {
+	client.Client
+	Log logr.Logger
+}
+
+func (r *readinessReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	instance := &dscv1.DataScienceCluster{}
+	if err := r.Client.Get(ctx, req.NamespacedName, instance); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if !instance.DeletionTimestamp.IsZero() || isPlanOnly(instance) {
+		return ctrl.Result{}, nil
+	}
+
+	updates := map[string][]dscv1.WorkloadStatus{}
+	pending := false
+	for componentName, componentStatus := range instance.Status.Components {
+		if !readinessManaged(instance.Status.Conditions, componentName) {
+			continue
+		}
+		workloads := workloadStatuses(ctx, r.Client, workloadReferences(componentStatus.Workloads))
+		pending = pending || !workloadsReady(workloads)
+
+		condition := conditionsv1.FindStatusCondition(instance.Status.Conditions, conditionsv1.ConditionType(componentName+status.ReadySuffix))
+		conditionStatus, reason, message := readiness(workloads)
+		if !equality.Semantic.DeepEqual(workloads, componentStatus.Workloads) ||
+			condition.Status != conditionStatus || condition.Reason != reason || condition.Message != message {
+			updates[componentName] = workloads
+		}
+	}
+
+	if len(updates) != 0 {
+		r.Log.Info("Updating readiness of components", "name", instance.Name, "components", len(updates))
+		_, err := status.UpdateWithRetry(ctx, r.Client, instance, func(saved *dscv1.DataScienceCluster) {
+			for componentName, workloads := range updates {
+				componentStatus, found := saved.Status.Components[componentName]
+				if !found || !readinessManaged(saved.Status.Conditions, componentName) {
+					continue
+				}
+				componentStatus.Workloads = workloads
+				saved.Status.Components[componentName] = componentStatus
+				setReadinessCondition(&saved.Status.Conditions, componentName, workloads)
+			}
+		})
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	if pending {
+		return ctrl.Result{RequeueAfter: readinessRequeueAfter}, nil
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// readinessManaged tells whether the last reconciliation of the component succeeded, in which case its readiness only
+// depends on its workloads. Failed and skipped components keep the condition set by the DataScienceCluster controller.
+func readinessManaged(conditions []conditionsv1.Condition, componentName string) bool {
+	condition := conditionsv1.FindStatusCondition(conditions, conditionsv1.ConditionType(componentName+status.ReadySuffix))
+
+	return condition != nil && (condition.Reason == status.ReconcileCompleted || condition.Reason == status.WorkloadsNotReady)
+}
+
+// readinessWorkloads returns the workloads the readiness of the component depends on.
+func readinessWorkloads(component components.ComponentInterface, inventory *deploy.Inventory,
+	dscispec *dsciv1.DSCInitializationSpec,
+) []corev1.ObjectReference {
+	if selector, ok := component.(components.WorkloadsSelector); ok {
+		return selector.ReadinessWorkloads(inventory.Workloads(), dscispec)
+	}
+
+	return inventory.Workloads()
+}
+
+// setReadinessCondition sets the ready condition of a component which has been reconciled successfully, from the health
+// of its workloads.
+func setReadinessCondition(conditions *[]conditionsv1.Condition, componentName string, workloads []dscv1.WorkloadStatus) {
+	conditionStatus, reason, message := readiness(workloads)
+	status.SetComponentCondition(conditions, componentName, reason, message, conditionStatus)
+}
+
+func readiness(workloads []dscv1.WorkloadStatus) (corev1.ConditionStatus, string, string) {
+	var messages []string
+	for _, workload := range workloads {
+		if !workload.Ready {
+			messages = append(messages, fmt.Sprintf("%s %s/%s: %s", workload.Kind, workload.Namespace, workload.Name, workload.Message))
+		}
+	}
+	if len(messages) != 0 {
+		return corev1.ConditionFalse, status.WorkloadsNotReady, "Component workloads are not ready: " + strings.Join(messages, "; ")
+	}
+
+	return corev1.ConditionTrue, status.ReconcileCompleted, "Component reconciled successfully"
+}
+
+func workloadsReady(workloads []dscv1.WorkloadStatus) bool {
+	for _, workload := range workloads {
+		if !workload.Ready {
+			return false
+		}
+	}
+
+	return true
+}
+
+func workloadReferences(workloads []dscv1.WorkloadStatus) []corev1.ObjectReference {
+	refs := make([]corev1.ObjectReference, 0, len(workloads))
+	for _, workload := range workloads {
+		refs = append(refs, corev1.ObjectReference{
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+			Kind:       workload.Kind,
+			Namespace:  workload.Namespace,
+			Name:       workload.Name,
+		})
+	}
+
+	return refs
+}
+
+// workloadStatuses evaluates the health of the given Deployments and StatefulSets.
+func workloadStatuses(ctx context.Context, cli client.Client, refs []corev1.ObjectReference) []dscv1.WorkloadStatus {
+	var workloads []dscv1.WorkloadStatus
+	for _, ref := range refs {
+		workload := dscv1.WorkloadStatus{Kind: ref.Kind, Namespace: ref.Namespace, Name: ref.Name}
+		var selector *metav1.LabelSelector
+		var err error
+		switch ref.Kind {
+		case "Deployment":
+			selector, err = deploymentHealth(ctx, cli, &workload)
+		case "StatefulSet":
+			selector, err = statefulSetHealth(ctx, cli, &workload)
+		default:
+			continue
+		}
+		switch {
+		case k8serr.IsNotFound(err):
+			workload.Message = "not found"
+		case err != nil:
+			workload.Message = err.Error()
+		case !workload.Ready:
+			if crashLoop := crashLoopingPod(ctx, cli, ref.Namespace, selector); crashLoop != "" {
+				workload.Message += ", " + crashLoop
+			}
+		}
+		workloads = append(workloads, workload)
+	}
+
+	return workloads
+}
+
+// deploymentHealth fills the health of a Deployment, and returns the selector of its pods.
+func deploymentHealth(ctx context.Context, cli client.Client, workload *dscv1.WorkloadStatus) (*metav1.LabelSelector, error) {
+	deployment := &appsv1.Deployment{}
+	if err := cli.Get(ctx, client.ObjectKey{Namespace: workload.Namespace, Name: workload.Name}, deployment); err != nil {
+		return nil, err
+	}
+
+	workload.Replicas = 1
+	if deployment.Spec.Replicas != nil {
+		workload.Replicas = *deployment.Spec.Replicas
+	}
+	workload.AvailableReplicas = deployment.Status.AvailableReplicas
+
+	switch {
+	case deployment.Status.ObservedGeneration < deployment.Generation:
+		workload.Message = "rollout not started yet"
+	case progressDeadlineExceeded(deployment):
+		workload.Message = "rollout stuck"
+	case deployment.Status.UpdatedReplicas < workload.Replicas:
+		workload.Message = fmt.Sprintf("rollout in progress, %d/%d replicas updated", deployment.Status.UpdatedReplicas, workload.Replicas)
+	case deployment.Status.AvailableReplicas < workload.Replicas:
+		workload.Message = fmt.Sprintf("%d/%d replicas available", deployment.Status.AvailableReplicas, workload.Replicas)
+	default:
+		workload.Ready = true
+	}
+
+	return deployment.Spec.Selector, nil
+}
+
+func progressDeadlineExceeded(deployment *appsv1.Deployment) bool {
+	for _, condition := range deployment.Status.Conditions {
+		if condition.Type == appsv1.DeploymentProgressing {
+			return condition.Status == corev1.ConditionFalse && condition.Reason == "ProgressDeadlineExceeded"
+		}
+	}
+
+	return false
+}
+
+// statefulSetHealth fills the health of a StatefulSet, and returns the selector of its pods.
+func statefulSetHealth(ctx context.Context, cli client.Client, workload *dscv1.WorkloadStatus) (*metav1.LabelSelector, error) {
+	statefulSet := &appsv1.StatefulSet{}
+	if err := cli.Get(ctx, client.ObjectKey{Namespace: workload.Namespace, Name: workload.Name}, statefulSet); err != nil {
+		return nil, err
+	}
+
+	workload.Replicas = 1
+	if statefulSet.Spec.Replicas != nil {
+		workload.Replicas = *statefulSet.Spec.Replicas
+	}
+	workload.AvailableReplicas = statefulSet.Status.AvailableReplicas
+
+	switch {
+	case statefulSet.Status.ObservedGeneration < statefulSet.Generation:
+		workload.Message = "rollout not started yet"
+	case statefulSet.Status.UpdateRevision != statefulSet.Status.CurrentRevision && statefulSet.Status.UpdatedReplicas < workload.Replicas:
+		workload.Message = fmt.Sprintf("rollout in progress, %d/%d replicas updated", statefulSet.Status.UpdatedReplicas, workload.Replicas)
+	case statefulSet.Status.AvailableReplicas < workload.Replicas:
+		workload.Message = fmt.Sprintf("%d/%d replicas available", statefulSet.Status.AvailableReplicas, workload.Replicas)
+	default:
+		workload.Ready = true
+	}
+
+	return statefulSet.Spec.Selector, nil
+}
+
+// crashLoopingPod describes the first pod of the workload with a container in CrashLoopBackOff, if any.
+func crashLoopingPod(ctx context.Context, cli client.Client, namespace string, selector *metav1.LabelSelector) string {
+	if selector == nil {
+		return ""
+	}
+	podSelector, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return ""
+	}
+	pods := &corev1.PodList{}
+	if err := cli.List(ctx, pods, client.InNamespace(namespace), client.MatchingLabelsSelector{Selector: podSelector}); err != nil {
+		return ""
+	}
+
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		for _, containerStatus := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+			if containerStatus.State.Waiting != nil && containerStatus.State.Waiting.Reason == "CrashLoopBackOff" {
+				return fmt.Sprintf("container %s of pod %s is crash looping after %d restarts", containerStatus.Name, pod.Name, containerStatus.RestartCount)
+			}
+		}
+	}
+
+	return ""
+}
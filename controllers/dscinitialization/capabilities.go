@@ -9,6 +9,7 @@ import (
 
 	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
 )
 
@@ -45,6 +46,9 @@ func createCapabilityReporter(cli client.Client, object *dsciv1.DSCInitializatio
 					if errors.As(err, &missingOperatorErr) {
 						actualCondition.Reason = status.MissingOperatorReason
 					}
+					if cluster.IsNotReady(err) {
+						actualCondition.Reason = status.Waiting
+					}
 				}
 				conditionsv1.SetStatusCondition(&saved.Status.Conditions, *actualCondition)
 			}
@@ -0,0 +1,90 @@
+package dscinitialization
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+)
+
+// DeclaredFeaturesConfigMap is the name of the ConfigMap of the operator namespace declaring features specific to the
+// cluster, e.g. additional gateways, which are applied along with the features of the DSCInitialization.
+const DeclaredFeaturesConfigMap = "odh-declared-features"
+
+// applyDeclaredFeatures applies the features declared in the ConfigMap, and removes the ones which are not declared
+// anymore, all of them when the ConfigMap does not exist.
+func (r *DSCInitializationReconciler) applyDeclaredFeatures(ctx context.Context, instance *dsciv1.DSCInitialization) error {
+	configMap, err := r.declaredFeaturesConfigMap(ctx)
+	if err != nil || configMap == nil {
+		return err
+	}
+
+	if err := feature.RemoveUndeclaredFeatures(ctx, r.Client, instance, configMap, nil); err != nil {
+		r.Log.Error(err, "failed removing features not declared anymore", "configmap", configMap.Name)
+
+		return err
+	}
+
+	if err := feature.DeclaredFeaturesHandler(instance, configMap, nil).Apply(ctx); err != nil {
+		if !cluster.IsNotReady(err) {
+			r.Log.Error(err, "failed applying declared features", "configmap", configMap.Name)
+			r.Recorder.Eventf(instance, corev1.EventTypeWarning, "DSCInitializationReconcileError", "failed applying features declared in %s: %v",
+				configMap.Name, err)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// removeDeclaredFeatures removes all the features declared in the ConfigMap along with the DSCInitialization, including
+// the ones applied before they have been removed from the ConfigMap.
+func (r *DSCInitializationReconciler) removeDeclaredFeatures(ctx context.Context, instance *dsciv1.DSCInitialization) error {
+	configMap, err := r.declaredFeaturesConfigMap(ctx)
+	if err != nil || configMap == nil {
+		return err
+	}
+
+	return feature.RemoveUndeclaredFeatures(ctx, r.Client, instance, &corev1.ConfigMap{ObjectMeta: configMap.ObjectMeta}, nil)
+}
+
+// declaredFeaturesConfigMap returns the ConfigMap declaring features, which is empty when it does not exist. It
+// returns nil when the operator does not run in a namespace, e.g. while running locally.
+func (r *DSCInitializationReconciler) declaredFeaturesConfigMap(ctx context.Context) (*corev1.ConfigMap, error) {
+	operatorNs, err := cluster.GetOperatorNamespace()
+	if err != nil {
+		return nil, nil //nolint:nilerr // Reason: features can only be declared in the namespace of the operator
+	}
+
+	configMap := &corev1.ConfigMap{}
+	err = r.Client.Get(ctx, client.ObjectKey{Name: DeclaredFeaturesConfigMap, Namespace: operatorNs}, configMap)
+	if k8serr.IsNotFound(err) {
+		return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: DeclaredFeaturesConfigMap, Namespace: operatorNs}}, nil
+	}
+
+	return configMap, err
+}
+
+func (r *DSCInitializationReconciler) watchDeclaredFeaturesConfigMap(a client.Object) []reconcile.Request {
+	operatorNs, err := cluster.GetOperatorNamespace()
+	if err != nil {
+		return nil
+	}
+
+	if a.GetName() == DeclaredFeaturesConfigMap && a.GetNamespace() == operatorNs {
+		r.Log.Info("Found declared features have changed, start reconcile")
+
+		return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: DeclaredFeaturesConfigMap, Namespace: operatorNs}}}
+	}
+
+	return nil
+}
@@ -44,9 +44,12 @@ import (
 
 	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/datasciencecluster/v1"
 	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/featuretracker"
 	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/trustedcabundle"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/upgrade"
 )
@@ -111,6 +114,9 @@ func (r *DSCInitializationReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		}
 	} else {
 		r.Log.Info("Finalization DSCInitialization start deleting instance", "name", instance.Name, "finalizer", finalizerName)
+		if err := r.removeDeclaredFeatures(ctx, instance); err != nil {
+			return reconcile.Result{}, err
+		}
 		if err := r.removeServiceMesh(ctx, instance); err != nil {
 			return reconcile.Result{}, err
 		}
@@ -203,6 +209,23 @@ func (r *DSCInitializationReconciler) Reconcile(ctx context.Context, req ctrl.Re
 			// no need to log error as it was already logged in createOdhNamespace
 			return reconcile.Result{}, err
 		}
+		if err := r.createDataScienceClusterNamespaces(ctx, instance); err != nil {
+			return reconcile.Result{}, err
+		}
+
+		// Move the monitoring stack when its namespace changed, the secrets provided for it are needed to deploy it
+		previousMonitoringNamespace := ""
+		if instance.Spec.Monitoring.ManagementState == operatorv1.Managed {
+			previousMonitoringNamespace = r.monitoringNamespaceToMigrate(instance)
+		}
+		if previousMonitoringNamespace != "" {
+			r.Log.Info("Migrating monitoring stack", "from", previousMonitoringNamespace, "to", instance.Spec.Monitoring.Namespace)
+			if err := r.copyMonitoringSecrets(ctx, instance, previousMonitoringNamespace); err != nil {
+				r.Recorder.Eventf(instance, corev1.EventTypeWarning, "DSCInitializationReconcileError", "Failed to migrate monitoring stack: %v", err)
+
+				return reconcile.Result{}, err
+			}
+		}
 
 		// Start reconciling
 		if instance.Status.Conditions == nil {
@@ -264,16 +287,60 @@ func (r *DSCInitializationReconciler) Reconcile(ctx context.Context, req ctrl.Re
 			}
 		}
 
+		// The stack is deployed in the new namespace, so it can be removed from the previous one
+		if previousMonitoringNamespace != "" {
+			if err := r.cleanupMonitoringNamespace(ctx, instance, previousMonitoringNamespace); err != nil {
+				r.Recorder.Eventf(instance, corev1.EventTypeWarning, "DSCInitializationReconcileError", "Failed to migrate monitoring stack: %v", err)
+
+				return reconcile.Result{}, err
+			}
+			if err := r.Patch(ctx, instance, client.RawPatch(types.MergePatchType,
+				[]byte(`{"metadata":{"annotations":{"`+annotations.MigrateMonitoringNamespace+`":null}}}`))); err != nil {
+				return reconcile.Result{}, err
+			}
+			r.Recorder.Eventf(instance, corev1.EventTypeNormal, "MonitoringNamespaceMigrated",
+				"Monitoring stack moved from namespace %s to %s", previousMonitoringNamespace, instance.Spec.Monitoring.Namespace)
+		}
+
 		// Apply Service Mesh configurations
-		if errServiceMesh := r.configureServiceMesh(ctx, instance); errServiceMesh != nil {
+		if errServiceMesh := r.ConfigureServiceMesh(ctx, instance); errServiceMesh != nil {
+			if notReady, waiting := cluster.AsNotReady(errServiceMesh); waiting {
+				// Service Mesh is not ready yet, resume later instead of blocking the reconciliation
+				r.Log.Info("waiting for Service Mesh", "reason", notReady.Message)
+				_, err = status.UpdateWithRetry(ctx, r.Client, instance, func(saved *dsciv1.DSCInitialization) {
+					status.SetProgressingCondition(&saved.Status.Conditions, status.Waiting, "Waiting for Service Mesh: "+notReady.Message)
+					saved.Status.Phase = status.PhaseProgressing
+				})
+
+				return reconcile.Result{RequeueAfter: notReady.RequeueAfter}, err
+			}
+
 			return reconcile.Result{}, errServiceMesh
 		}
 
+		// Apply features declared for the cluster, which may rely on Service Mesh
+		if errFeatures := r.applyDeclaredFeatures(ctx, instance); errFeatures != nil {
+			if notReady, waiting := cluster.AsNotReady(errFeatures); waiting {
+				r.Log.Info("waiting for declared features", "reason", notReady.Message)
+				_, err = status.UpdateWithRetry(ctx, r.Client, instance, func(saved *dsciv1.DSCInitialization) {
+					status.SetProgressingCondition(&saved.Status.Conditions, status.Waiting, "Waiting for declared features: "+notReady.Message)
+					saved.Status.Phase = status.PhaseProgressing
+				})
+
+				return reconcile.Result{RequeueAfter: notReady.RequeueAfter}, err
+			}
+
+			return reconcile.Result{}, errFeatures
+		}
+
 		// Finish reconciling
 		_, err = status.UpdateWithRetry[*dsciv1.DSCInitialization](ctx, r.Client, instance, func(saved *dsciv1.DSCInitialization) {
 			status.SetCompleteCondition(&saved.Status.Conditions, status.ReconcileCompleted, status.ReconcileCompletedMessage)
 			saved.Status.Phase = status.PhaseReady
 			saved.Status.Release = currentOperatorReleaseVersion
+			if saved.Spec.Monitoring.ManagementState == operatorv1.Managed && (saved.Status.MonitoringNamespace == "" || previousMonitoringNamespace != "") {
+				saved.Status.MonitoringNamespace = saved.Spec.Monitoring.Namespace
+			}
 		})
 		if err != nil {
 			r.Log.Error(err, "failed to update DSCInitialization status after successfully completed reconciliation")
@@ -306,6 +373,8 @@ func (r *DSCInitializationReconciler) SetupWithManager(ctx context.Context, mgr
 		Watches(&source.Kind{Type: &dscv1.DataScienceCluster{}}, handler.EnqueueRequestsFromMapFunc(r.watchDSCResource(ctx)), builder.WithPredicates(DSCDeletionPredicate)).
 		Watches(&source.Kind{Type: &corev1.Secret{}}, handler.EnqueueRequestsFromMapFunc(r.watchMonitoringSecretResource), builder.WithPredicates(SecretContentChangedPredicate)).
 		Watches(&source.Kind{Type: &corev1.ConfigMap{}}, handler.EnqueueRequestsFromMapFunc(r.watchMonitoringConfigMapResource), builder.WithPredicates(CMContentChangedPredicate)).
+		Watches(&source.Kind{Type: &corev1.ConfigMap{}}, handler.EnqueueRequestsFromMapFunc(r.watchDeclaredFeaturesConfigMap), builder.WithPredicates(CMContentChangedPredicate)).
+		Watches(&source.Kind{Type: &featurev1.FeatureTracker{}}, handler.EnqueueRequestsFromMapFunc(r.watchFeatureTrackers), builder.WithPredicates(featuretracker.ReapplyPredicate)).
 		Complete(r)
 }
 
@@ -369,8 +438,24 @@ func (r *DSCInitializationReconciler) watchMonitoringSecretResource(a client.Obj
 	return nil
 }
 
+// watchFeatureTrackers applies the features of the DSCInitialization again when objects of one of them have been changed.
+// Features of components are applied by the DataScienceCluster controller.
+func (r *DSCInitializationReconciler) watchFeatureTrackers(a client.Object) []reconcile.Request {
+	tracker, ok := a.(*featurev1.FeatureTracker)
+	if !ok || tracker.Spec.Source.Type == featurev1.ComponentType {
+		return nil
+	}
+	r.Log.Info("Found objects of feature have been changed, start reconcile", "featuretracker", tracker.Name)
+
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: tracker.Spec.Source.Name}}}
+}
+
 func (r *DSCInitializationReconciler) watchDSCResource(ctx context.Context) func(client.Object) []reconcile.Request {
-	return func(_ client.Object) []reconcile.Request {
+	return func(a client.Object) []reconcile.Request {
+		// Namespaces of DataScienceClusters not using the applications namespace are created by the DSCInitialization
+		if dsc, ok := a.(*dscv1.DataScienceCluster); ok && dsc.Spec.ApplicationsNamespace != "" && dsc.DeletionTimestamp.IsZero() {
+			return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: dsc.Name}}}
+		}
 		instanceList := &dscv1.DataScienceClusterList{}
 		if err := r.Client.List(ctx, instanceList); err != nil {
 			// do not handle if cannot get list
@@ -11,6 +11,7 @@ import (
 	routev1 "github.com/openshift/api/route/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -20,6 +21,9 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/common"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/monitoring"
 )
 
 // +kubebuilder:rbac:groups="route.openshift.io",resources=routers/metrics,verbs=get
@@ -27,52 +31,39 @@ import (
 // +kubebuilder:rbac:groups="image.openshift.io",resources=registry/metrics,verbs=get
 
 var (
-	ComponentName           = "monitoring"
-	alertManagerPath        = filepath.Join(deploy.DefaultManifestPath, ComponentName, "alertmanager")
-	prometheusManifestsPath = filepath.Join(deploy.DefaultManifestPath, ComponentName, "prometheus", "base")
-	prometheusConfigPath    = filepath.Join(deploy.DefaultManifestPath, ComponentName, "prometheus", "apps")
-	networkpolicyPath       = filepath.Join(deploy.DefaultManifestPath, ComponentName, "networkpolicy")
-	NameConsoleLink         = "console"
-	NamespaceConsoleLink    = "openshift-console"
+	ComponentName             = "monitoring"
+	alertManagerFolder        = "alertmanager"
+	prometheusManifestsFolder = filepath.Join("prometheus", "base")
+	monitoringBaseFolder      = "base"
+	networkpolicyPath         = filepath.Join(deploy.DefaultManifestPath, ComponentName, "networkpolicy")
+	NameConsoleLink           = "console"
+	NamespaceConsoleLink      = "openshift-console"
 )
 
 // only when reconcile on DSCI CR, initial set to true
 // if reconcile from monitoring, initial set to false, skip blackbox and rolebinding.
 func (r *DSCInitializationReconciler) configureManagedMonitoring(ctx context.Context, dscInit *dsciv1.DSCInitialization, initial string) error {
+	// Manifests of the monitoring stack are rendered in a workspace, the ones shipped with the operator are left as they are
+	workspace, err := deploy.NewWorkspace(ComponentName, ComponentName)
+	if err != nil {
+		return err
+	}
+	defer workspace.Close()
+
 	if initial == "init" {
 		// configure Blackbox exporter
 		if err := configureBlackboxExporter(ctx, dscInit, r); err != nil {
 			return fmt.Errorf("error in configureBlackboxExporter: %w", err)
 		}
 	}
-	if initial == "revertbackup" {
-		// TODO: implement with a better solution
-		// to have - before component name is to filter out the real rules file line
-		// e.g line of "workbenches-recording.rules: |"
-		err := common.MatchLineInFile(filepath.Join(prometheusConfigPath, "prometheus-configs.yaml"),
-			map[string]string{
-				"(.*)-(.*)workbenches(.*).rules":                     "",
-				"(.*)-(.*)rhods-dashboard(.*).rules":                 "",
-				"(.*)-(.*)codeflare(.*).rules":                       "",
-				"(.*)-(.*)data-science-pipelines-operator(.*).rules": "",
-				"(.*)-(.*)model-mesh(.*).rules":                      "",
-				"(.*)-(.*)odh-model-controller(.*).rules":            "",
-				"(.*)-(.*)ray(.*).rules":                             "",
-				"(.*)-(.*)trustyai(.*).rules":                        "",
-			})
-		if err != nil {
-			r.Log.Error(err, "error to remove previous enabled component rules")
-			return err
-		}
-	}
 
 	// configure Alertmanager
-	if err := configureAlertManager(ctx, dscInit, r); err != nil {
+	if err := configureAlertManager(ctx, dscInit, r, workspace); err != nil {
 		return fmt.Errorf("error in configureAlertManager: %w", err)
 	}
 
-	// configure Prometheus
-	if err := configurePrometheus(ctx, dscInit, r); err != nil {
+	// configure Prometheus, removing the previously enabled component rules when reverting back
+	if err := configurePrometheus(ctx, dscInit, r, workspace, initial == "revertbackup"); err != nil {
 		return fmt.Errorf("error in configurePrometheus: %w", err)
 	}
 
@@ -87,7 +78,9 @@ func (r *DSCInitializationReconciler) configureManagedMonitoring(ctx context.Con
 	return nil
 }
 
-func configureAlertManager(ctx context.Context, dsciInit *dsciv1.DSCInitialization, r *DSCInitializationReconciler) error {
+func configureAlertManager(ctx context.Context, dsciInit *dsciv1.DSCInitialization, r *DSCInitializationReconciler, workspace *deploy.Workspace) error {
+	alertManagerPath := workspace.Path(ComponentName, alertManagerFolder)
+
 	// Get Deadmansnitch secret
 	deadmansnitchSecret, err := r.waitForManagedSecret(ctx, "redhat-rhods-deadmanssnitch", dsciInit.Spec.Monitoring.Namespace)
 	if err != nil {
@@ -197,7 +190,11 @@ func configureAlertManager(ctx context.Context, dsciInit *dsciv1.DSCInitializati
 	return nil
 }
 
-func configurePrometheus(ctx context.Context, dsciInit *dsciv1.DSCInitialization, r *DSCInitializationReconciler) error {
+func configurePrometheus(ctx context.Context, dsciInit *dsciv1.DSCInitialization, r *DSCInitializationReconciler,
+	workspace *deploy.Workspace, resetRules bool,
+) error {
+	prometheusManifestsPath := workspace.Path(ComponentName, prometheusManifestsFolder)
+
 	// Update rolebinding-viewer
 	err := common.ReplaceStringsInFile(filepath.Join(prometheusManifestsPath, "prometheus-rolebinding-viewer.yaml"),
 		map[string]string{
@@ -207,32 +204,9 @@ func configurePrometheus(ctx context.Context, dsciInit *dsciv1.DSCInitialization
 		r.Log.Error(err, "error to inject data to prometheus-rolebinding-viewer.yaml")
 		return err
 	}
-	// Update prometheus-config for dashboard, dsp and workbench
-	consolelinkDomain, err := cluster.GetDomain(ctx, r.Client)
-	if err != nil {
-		return fmt.Errorf("error getting console route URL : %w", err)
-	}
-	err = common.ReplaceStringsInFile(filepath.Join(prometheusConfigPath, "prometheus-configs.yaml"),
-		map[string]string{
-			"<odh_application_namespace>": dsciInit.Spec.ApplicationsNamespace,
-			"<odh_monitoring_project>":    dsciInit.Spec.Monitoring.Namespace,
-			"<console_domain>":            consolelinkDomain,
-		})
-	if err != nil {
-		r.Log.Error(err, "error to inject data to prometheus-configs.yaml")
-		return err
-	}
-
-	// Deploy prometheus manifests from prometheus/apps
-	if err = deploy.DeployManifestsFromPath(
-		ctx,
-		r.Client,
-		dsciInit,
-		prometheusConfigPath,
-		dsciInit.Spec.Monitoring.Namespace,
-		"prometheus",
-		dsciInit.Spec.Monitoring.ManagementState == operatorv1.Managed); err != nil {
-		r.Log.Error(err, "error to deploy manifests for prometheus configs", "path", prometheusConfigPath)
+	// Deploy prometheus-config for dashboard, dsp and workbench from prometheus/apps
+	if err = monitoring.DeployPrometheusConfig(ctx, r.Client, dsciInit, resetRules); err != nil {
+		r.Log.Error(err, "error to deploy manifests for prometheus configs")
 		return err
 	}
 	// r.Log.Info("Success: create prometheus configmap 'prometheus'")
@@ -466,9 +440,14 @@ func (r *DSCInitializationReconciler) configureCommonMonitoring(ctx context.Cont
 		return err
 	}
 
-	// configure monitoring base
-	monitoringBasePath := filepath.Join(deploy.DefaultManifestPath, "monitoring", "base")
-	err := common.ReplaceStringsInFile(filepath.Join(monitoringBasePath, "rhods-servicemonitor.yaml"),
+	// configure monitoring base, rendered in a workspace
+	workspace, err := deploy.NewWorkspace(ComponentName, ComponentName)
+	if err != nil {
+		return err
+	}
+	defer workspace.Close()
+	monitoringBasePath := workspace.Path(ComponentName, monitoringBaseFolder)
+	err = common.ReplaceStringsInFile(filepath.Join(monitoringBasePath, "rhods-servicemonitor.yaml"),
 		map[string]string{
 			"<odh_monitoring_project>": dsciInit.Spec.Monitoring.Namespace,
 		})
@@ -491,3 +470,91 @@ func (r *DSCInitializationReconciler) configureCommonMonitoring(ctx context.Cont
 	}
 	return nil
 }
+
+// managedMonitoringSecrets are provided for the monitoring stack in its namespace, and are moved along with it.
+var managedMonitoringSecrets = []string{"redhat-rhods-deadmanssnitch", "redhat-rhods-pagerduty", "redhat-rhods-smtp"}
+
+// monitoringNamespaceToMigrate returns the namespace the monitoring stack has to be moved from, when the monitoring
+// namespace changed and the migration was requested with the MigrateMonitoringNamespace annotation.
+func (r *DSCInitializationReconciler) monitoringNamespaceToMigrate(dscInit *dsciv1.DSCInitialization) string {
+	previous := dscInit.Status.MonitoringNamespace
+	if previous == "" || previous == dscInit.Spec.Monitoring.Namespace {
+		return ""
+	}
+	if dscInit.GetAnnotations()[annotations.MigrateMonitoringNamespace] != "true" {
+		r.Recorder.Eventf(dscInit, corev1.EventTypeWarning, "MonitoringNamespaceChanged",
+			"Monitoring stack is left in namespace %s, set annotation %s to \"true\" to migrate it to %s",
+			previous, annotations.MigrateMonitoringNamespace, dscInit.Spec.Monitoring.Namespace)
+
+		return ""
+	}
+
+	return previous
+}
+
+// copyMonitoringSecrets copies the secrets provided for the monitoring stack to its new namespace, so it can be deployed
+// there without waiting for them to be provided again.
+func (r *DSCInitializationReconciler) copyMonitoringSecrets(ctx context.Context, dscInit *dsciv1.DSCInitialization, previous string) error {
+	for _, name := range managedMonitoringSecrets {
+		secret := &corev1.Secret{}
+		if err := r.Client.Get(ctx, client.ObjectKey{Name: name, Namespace: previous}, secret); err != nil {
+			if k8serr.IsNotFound(err) {
+				continue
+			}
+
+			return fmt.Errorf("error getting secret %s from namespace %s: %w", name, previous, err)
+		}
+		moved := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   dscInit.Spec.Monitoring.Namespace,
+				Labels:      secret.Labels,
+				Annotations: secret.Annotations,
+			},
+			Type: secret.Type,
+			Data: secret.Data,
+		}
+		if err := r.Client.Create(ctx, moved); err != nil && !k8serr.IsAlreadyExists(err) {
+			return fmt.Errorf("error copying secret %s to namespace %s: %w", name, dscInit.Spec.Monitoring.Namespace, err)
+		}
+	}
+
+	return nil
+}
+
+// cleanupMonitoringNamespace removes the monitoring stack from the namespace it was moved from. Namespaces created by the
+// operator are deleted, otherwise only objects deployed for the stack are.
+func (r *DSCInitializationReconciler) cleanupMonitoringNamespace(ctx context.Context, dscInit *dsciv1.DSCInitialization, previous string) error {
+	namespace := &corev1.Namespace{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Name: previous}, namespace); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	if namespace.Labels[labels.ODH.OwnedNamespace] == "true" && previous != dscInit.Spec.ApplicationsNamespace {
+		r.Log.Info("Deleting previous monitoring namespace", "name", previous)
+
+		return client.IgnoreNotFound(r.Client.Delete(ctx, namespace))
+	}
+
+	r.Log.Info("Deleting monitoring stack from previous namespace", "name", previous)
+	stackObjects := []client.Object{
+		&appsv1.Deployment{}, &corev1.Service{}, &corev1.ConfigMap{}, &corev1.Secret{}, &corev1.ServiceAccount{},
+		&corev1.PersistentVolumeClaim{}, &routev1.Route{}, &networkingv1.NetworkPolicy{},
+	}
+	for _, componentName := range []string{"alertmanager", "prometheus", "blackbox-exporter", "networkpolicy"} {
+		for _, obj := range stackObjects {
+			if err := r.Client.DeleteAllOf(ctx, obj, client.InNamespace(previous),
+				client.MatchingLabels{labels.K8SCommon.PartOf: componentName}); err != nil && !k8serr.IsNotFound(err) {
+				return fmt.Errorf("error deleting monitoring stack from namespace %s: %w", previous, err)
+			}
+		}
+	}
+	for _, name := range append([]string{"prometheus-proxy", "alertmanager-proxy"}, managedMonitoringSecrets...) {
+		secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: previous}}
+		if err := r.Client.Delete(ctx, secret); err != nil && !k8serr.IsNotFound(err) {
+			return fmt.Errorf("error deleting secret %s from namespace %s: %w", name, previous, err)
+		}
+	}
+
+	return nil
+}
@@ -16,7 +16,9 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature/servicemesh"
 )
 
-func (r *DSCInitializationReconciler) configureServiceMesh(ctx context.Context, instance *dsciv1.DSCInitialization) error {
+// ConfigureServiceMesh applies or removes Service Mesh capabilities according to the DSCInitialization spec,
+// reporting their state in its status conditions. It is also used by odh-render to render Service Mesh features.
+func (r *DSCInitializationReconciler) ConfigureServiceMesh(ctx context.Context, instance *dsciv1.DSCInitialization) error {
 	serviceMeshManagementState := operatorv1.Removed
 	if instance.Spec.ServiceMesh != nil {
 		serviceMeshManagementState = instance.Spec.ServiceMesh.ManagementState
@@ -39,6 +41,9 @@ func (r *DSCInitializationReconciler) configureServiceMesh(ctx context.Context,
 
 		for _, capability := range capabilities {
 			capabilityErr := capability.Apply(ctx)
+			if cluster.IsNotReady(capabilityErr) {
+				return capabilityErr
+			}
 			if capabilityErr != nil {
 				r.Log.Error(capabilityErr, "failed applying service mesh resources")
 				r.Recorder.Eventf(instance, corev1.EventTypeWarning, "DSCInitializationReconcileError", "failed applying service mesh resources")
@@ -126,7 +131,7 @@ func (r *DSCInitializationReconciler) authorizationCapability(ctx context.Contex
 func (r *DSCInitializationReconciler) serviceMeshCapabilityFeatures(instance *dsciv1.DSCInitialization) feature.FeaturesProvider {
 	return func(handler *feature.FeaturesHandler) (err error) { //nolint:lll,nonamedreturns // Reason: we use the named return to handle errors in a unified fashion through deferred function.
 		serviceMeshSpec := instance.Spec.ServiceMesh
-		smcpCreationErr := feature.CreateFeature("mesh-control-plane-creation").
+		smcpCreationErr := feature.CreateFeature(feature.MeshControlPlaneCreation).
 			For(handler).
 			ManifestsLocation(Templates.Location).
 			Manifests(
@@ -146,8 +151,9 @@ func (r *DSCInitializationReconciler) serviceMeshCapabilityFeatures(instance *ds
 		}
 
 		if serviceMeshSpec.ControlPlane.MetricsCollection == "Istio" {
-			metricsCollectionErr := feature.CreateFeature("mesh-metrics-collection").
+			metricsCollectionErr := feature.CreateFeature(feature.MeshMetricsCollection).
 				For(handler).
+				DependsOn(feature.MeshControlPlaneCreation).
 				PreConditions(
 					servicemesh.EnsureServiceMeshInstalled,
 				).
@@ -161,7 +167,7 @@ func (r *DSCInitializationReconciler) serviceMeshCapabilityFeatures(instance *ds
 			}
 		}
 
-		cfgMapErr := feature.CreateFeature("mesh-shared-configmap").
+		cfgMapErr := feature.CreateFeature(feature.MeshSharedConfigMap).
 			For(handler).
 			WithResources(servicemesh.MeshRefs, servicemesh.AuthRefs).
 			Load()
@@ -177,7 +183,7 @@ func (r *DSCInitializationReconciler) authorizationFeatures(instance *dsciv1.DSC
 	return func(handler *feature.FeaturesHandler) error {
 		serviceMeshSpec := instance.Spec.ServiceMesh
 
-		extAuthzErr := feature.CreateFeature("mesh-control-plane-external-authz").
+		extAuthzErr := feature.CreateFeature(feature.MeshControlPlaneExternalAuthz).
 			For(handler).
 			ManifestsLocation(Templates.Location).
 			Manifests(
@@ -205,9 +211,6 @@ func (r *DSCInitializationReconciler) authorizationFeatures(instance *dsciv1.DSC
 					return f.ApplyManifest(ctx, path.Join(Templates.AuthorinoDir, "deployment.injection.patch.tmpl.yaml"))
 				},
 			).
-			OnDelete(
-				servicemesh.RemoveExtensionProvider,
-			).
 			Load()
 		if extAuthzErr != nil {
 			return extAuthzErr
@@ -19,6 +19,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/datasciencecluster/v1"
 	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
@@ -140,6 +141,26 @@ func (r *DSCInitializationReconciler) createOdhNamespace(ctx context.Context, ds
 	return nil
 }
 
+// createDataScienceClusterNamespaces creates the applications namespaces of the DataScienceClusters which do not use
+// the one of the DSCInitialization, with the same defaults.
+func (r *DSCInitializationReconciler) createDataScienceClusterNamespaces(ctx context.Context, dscInit *dsciv1.DSCInitialization) error {
+	dscs := &dscv1.DataScienceClusterList{}
+	if err := r.List(ctx, dscs); err != nil {
+		return err
+	}
+	for i := range dscs.Items {
+		namespace := dscs.Items[i].GetApplicationsNamespace(dscInit.Spec.ApplicationsNamespace)
+		if namespace == dscInit.Spec.ApplicationsNamespace || !dscs.Items[i].DeletionTimestamp.IsZero() {
+			continue
+		}
+		if err := r.createOdhNamespace(ctx, dscInit, namespace); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (r *DSCInitializationReconciler) createDefaultRoleBinding(ctx context.Context, name string, dscInit *dsciv1.DSCInitialization) error {
 	// Expected namespace for the given name
 	desiredRoleBinding := &rbacv1.RoleBinding{
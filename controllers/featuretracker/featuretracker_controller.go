@@ -0,0 +1,176 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package featuretracker contains the controller detecting changes made to the objects of applied features.
+package featuretracker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/go-logr/logr"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+)
+
+// FeatureTrackerReconciler detects changes made to the objects created by features, which are owned by their
+// FeatureTracker, and reports them with the Drifted condition of the FeatureTracker. Features managed by the operator
+// are then applied again by the controller of their source, selected by ReapplyPredicate.
+type FeatureTrackerReconciler struct {
+	Client   client.Client
+	Log      logr.Logger
+	Recorder record.EventRecorder
+
+	controller controller.Controller
+	// watched are the kinds of the objects applied by features which are watched, as they are only known once
+	// FeatureTrackers record them.
+	watched sync.Map
+}
+
+// +kubebuilder:rbac:groups="features.opendatahub.io",resources=featuretrackers,verbs=get;list;watch
+// +kubebuilder:rbac:groups="features.opendatahub.io",resources=featuretrackers/status,verbs=get;update;patch
+
+func (r *FeatureTrackerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	tracker := &featurev1.FeatureTracker{}
+	if err := r.Client.Get(ctx, req.NamespacedName, tracker); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if !tracker.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	if err := r.watchAppliedKinds(tracker); err != nil {
+		return ctrl.Result{}, err
+	}
+	drift, err := feature.DetectDrift(ctx, r.Client, tracker)
+	if err != nil || drift == nil {
+		return ctrl.Result{}, err
+	}
+
+	condition := conditionsv1.Condition{
+		Type:    status.ConditionDrifted,
+		Status:  corev1.ConditionFalse,
+		Reason:  status.InSyncReason,
+		Message: "Objects of the feature are as applied",
+	}
+	current := conditionsv1.FindStatusCondition(tracker.Status.Conditions, status.ConditionDrifted)
+	changes := strings.Join(drift.Changes, "; ")
+	switch {
+	case len(drift.Resources) != 0 && drift.Managed:
+		// the feature is applied again by the controller of its source, which watches the condition
+		condition.Status = corev1.ConditionTrue
+		condition.Reason = status.ReapplyingReason
+		condition.Message = "Objects changed since the feature has been applied are applied again: " + changes
+	case len(drift.Resources) != 0:
+		condition.Status = corev1.ConditionTrue
+		condition.Reason = status.ObjectsChangedReason
+		condition.Message = "Objects have been changed since the feature has been applied: " + changes
+	case current != nil && (current.Reason == status.ReapplyingReason || current.Reason == status.ReappliedReason):
+		condition.Reason = status.ReappliedReason
+		condition.Message = "Objects changed since the feature has been applied have been applied again"
+	}
+
+	// the condition is only updated when it changes, so that its heartbeat does not trigger reconciliations
+	if current != nil && current.Status == condition.Status && current.Reason == condition.Reason && current.Message == condition.Message {
+		return ctrl.Result{}, nil
+	}
+	if condition.Reason == status.ReapplyingReason {
+		r.Log.Info("applying changed objects of feature again", "featuretracker", tracker.Name, "changes", changes)
+		r.Recorder.Eventf(tracker, corev1.EventTypeNormal, "FeatureReapplied", "Objects changed since the feature has been applied are applied again: %s", changes)
+	}
+	_, err = status.UpdateWithRetry(ctx, r.Client, tracker, func(saved *featurev1.FeatureTracker) {
+		conditionsv1.SetStatusCondition(&saved.Status.Conditions, condition)
+	})
+
+	return ctrl.Result{}, client.IgnoreNotFound(err)
+}
+
+// SetupWithManager sets up the controller with the Manager. Objects owned by FeatureTrackers are watched once their
+// kinds are recorded by FeatureTrackers.
+func (r *FeatureTrackerReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	var err error
+	r.controller, err = ctrl.NewControllerManagedBy(mgr).
+		For(&featurev1.FeatureTracker{}).
+		Build(r)
+
+	return err
+}
+
+// watchAppliedKinds watches the kinds of the objects applied by the feature of the tracker, which are not watched yet.
+func (r *FeatureTrackerReconciler) watchAppliedKinds(tracker *featurev1.FeatureTracker) error {
+	for _, resource := range tracker.Status.Resources {
+		if len(resource.AppliedFields) == 0 {
+			continue
+		}
+		gvk := resource.GroupVersionKind()
+		if _, watched := r.watched.LoadOrStore(gvk, true); watched {
+			continue
+		}
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(gvk)
+		if err := r.controller.Watch(&source.Kind{Type: obj}, &handler.EnqueueRequestForOwner{OwnerType: &featurev1.FeatureTracker{}}); err != nil {
+			r.watched.Delete(gvk)
+
+			return fmt.Errorf("failed to watch %s objects of feature tracker %s: %w", gvk.Kind, tracker.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// ReapplyPredicate selects the FeatureTrackers of features managed by the operator whose objects have been changed,
+// so that the controllers applying features apply them again.
+var ReapplyPredicate = predicate.Funcs{
+	CreateFunc: func(event.CreateEvent) bool {
+		return false
+	},
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		return IsReapplying(e.ObjectNew) && !IsReapplying(e.ObjectOld)
+	},
+	DeleteFunc: func(event.DeleteEvent) bool {
+		return false
+	},
+	GenericFunc: func(event.GenericEvent) bool {
+		return false
+	},
+}
+
+// IsReapplying tells whether the object is a FeatureTracker whose feature is to be applied again, as its objects have
+// been changed.
+func IsReapplying(obj client.Object) bool {
+	tracker, ok := obj.(*featurev1.FeatureTracker)
+	if !ok {
+		return false
+	}
+	condition := conditionsv1.FindStatusCondition(tracker.Status.Conditions, status.ConditionDrifted)
+
+	return condition != nil && condition.Reason == status.ReapplyingReason
+}
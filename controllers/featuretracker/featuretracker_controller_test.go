@@ -0,0 +1,53 @@
+package featuretracker_test
+
+import (
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/featuretracker"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Applying features again", func() {
+
+	trackerWithDrift := func(reason string) *featurev1.FeatureTracker {
+		tracker := featurev1.NewFeatureTracker("mesh-control-plane-creation", "opendatahub")
+		if reason != "" {
+			conditionsv1.SetStatusCondition(&tracker.Status.Conditions, conditionsv1.Condition{
+				Type:   status.ConditionDrifted,
+				Status: corev1.ConditionTrue,
+				Reason: reason,
+			})
+		}
+
+		return tracker
+	}
+
+	It("should select FeatureTrackers once their objects are to be applied again", func() {
+		Expect(featuretracker.ReapplyPredicate.Update(event.UpdateEvent{
+			ObjectOld: trackerWithDrift(status.InSyncReason),
+			ObjectNew: trackerWithDrift(status.ReapplyingReason),
+		})).To(BeTrue())
+		Expect(featuretracker.ReapplyPredicate.Update(event.UpdateEvent{
+			ObjectOld: trackerWithDrift(""),
+			ObjectNew: trackerWithDrift(status.ReapplyingReason),
+		})).To(BeTrue())
+	})
+
+	It("should not select FeatureTrackers already being applied again or not drifted", func() {
+		Expect(featuretracker.ReapplyPredicate.Update(event.UpdateEvent{
+			ObjectOld: trackerWithDrift(status.ReapplyingReason),
+			ObjectNew: trackerWithDrift(status.ReapplyingReason),
+		})).To(BeFalse())
+		Expect(featuretracker.ReapplyPredicate.Update(event.UpdateEvent{
+			ObjectOld: trackerWithDrift(status.InSyncReason),
+			ObjectNew: trackerWithDrift(status.ObjectsChangedReason),
+		})).To(BeFalse())
+		Expect(featuretracker.ReapplyPredicate.Create(event.CreateEvent{Object: trackerWithDrift(status.ReapplyingReason)})).To(BeFalse())
+	})
+})
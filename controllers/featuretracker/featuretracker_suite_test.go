@@ -0,0 +1,13 @@
+package featuretracker_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestFeatureTracker(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Feature tracker controller unit tests")
+}
@@ -60,6 +60,14 @@ const (
 	ReconcileCompleted                    = "ReconcileCompleted"
 	ReconcileCompletedWithComponentErrors = "ReconcileCompletedWithComponentErrors"
 	ReconcileCompletedMessage             = "Reconcile completed successfully"
+	// DependencyFailed is used when a component is not reconciled because one of the components it depends on failed.
+	DependencyFailed = "DependencyFailed"
+	// Waiting is used when the reconciliation waits for the cluster to reach some state, e.g. for pods to be ready, and is resumed later.
+	Waiting = "Waiting"
+	// WorkloadsNotReady is used when a component has been reconciled, but its Deployments or StatefulSets are not ready yet.
+	WorkloadsNotReady = "WorkloadsNotReady"
+	// FieldsChangedByOtherManagers is used when fields of objects deployed by a component have been changed by other field managers.
+	FieldsChangedByOtherManagers = "FieldsChangedByOtherManagers"
 
 	// ConditionReconcileComplete represents extra Condition Type, used by .Condition.Type.
 	ConditionReconcileComplete conditionsv1.ConditionType = "ReconcileComplete"
@@ -71,6 +79,22 @@ const (
 	CapabilityDSPv2Argo                conditionsv1.ConditionType = "CapabilityDSPv2Argo"
 )
 
+// ConditionDrifted is used by FeatureTrackers to report whether the objects of their feature have been changed since it
+// has been applied.
+const ConditionDrifted conditionsv1.ConditionType = "Drifted"
+
+const (
+	// ObjectsChangedReason is used when objects of a feature which is not managed by the operator have been changed.
+	ObjectsChangedReason = "ObjectsChanged"
+	// ReapplyingReason is used when objects of a feature managed by the operator have been changed, until the feature
+	// is applied again by the controller of its source.
+	ReapplyingReason = "Reapplying"
+	// ReappliedReason is used when changed objects of a feature managed by the operator have been applied again.
+	ReappliedReason = "Reapplied"
+	// InSyncReason is used when objects of a feature are as they have been applied.
+	InSyncReason = "InSync"
+)
+
 const (
 	MissingOperatorReason string = "MissingOperator"
 	ConfiguredReason      string = "Configured"
@@ -80,7 +104,8 @@ const (
 )
 
 const (
-	ReadySuffix = "Ready"
+	ReadySuffix         = "Ready"
+	FieldConflictSuffix = "FieldConflict"
 )
 
 // SetProgressingCondition sets the ProgressingCondition to True and other conditions to false or
@@ -209,3 +234,14 @@ func SetComponentCondition(conditions *[]conditionsv1.Condition, component strin
 func RemoveComponentCondition(conditions *[]conditionsv1.Condition, component string) {
 	conditionsv1.RemoveStatusCondition(conditions, conditionsv1.ConditionType(component+ReadySuffix))
 }
+
+// SetComponentFieldConflictCondition appends Condition Type with const FieldConflictSuffix for given component
+// when objects it deploys have fields changed by other field managers.
+func SetComponentFieldConflictCondition(conditions *[]conditionsv1.Condition, component string, message string) {
+	SetCondition(conditions, component+FieldConflictSuffix, FieldsChangedByOtherManagers, message, corev1.ConditionTrue)
+}
+
+// RemoveComponentFieldConflictCondition remove field conflict Condition of giving component.
+func RemoveComponentFieldConflictCondition(conditions *[]conditionsv1.Condition, component string) {
+	conditionsv1.RemoveStatusCondition(conditions, conditionsv1.ConditionType(component+FieldConflictSuffix))
+}
@@ -0,0 +1,109 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/datasciencecluster/v1"
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
+)
+
+//+kubebuilder:webhook:path=/mutate-opendatahub-io-v1,mutating=true,failurePolicy=fail,sideEffects=None,groups=datasciencecluster.opendatahub.io;dscinitialization.opendatahub.io,resources=datascienceclusters;dscinitializations,verbs=create;update,versions=v1,name=mutate.operator.opendatahub.io,admissionReviewVersions=v1
+//nolint:lll
+
+// OpenDataHubDefaulter materializes the effective defaults of DataScienceCluster and DSCInitialization objects, which
+// the operator would otherwise assume while reconciling them, so users and GitOps tools see the actual configuration.
+type OpenDataHubDefaulter struct {
+	// ApplicationsNamespace and MonitoringNamespace default the namespaces of the DSCInitialization, as configured
+	// for the operator.
+	ApplicationsNamespace string
+	MonitoringNamespace   string
+
+	decoder *admission.Decoder
+}
+
+func (d *OpenDataHubDefaulter) SetupWithManager(mgr ctrl.Manager) {
+	hookServer := mgr.GetWebhookServer()
+	odhWebhook := &webhook.Admission{
+		Handler: d,
+	}
+	hookServer.Register("/mutate-opendatahub-io-v1", odhWebhook)
+}
+
+func (d *OpenDataHubDefaulter) InjectDecoder(decoder *admission.Decoder) error {
+	d.decoder = decoder
+	return nil
+}
+
+func (d *OpenDataHubDefaulter) Handle(_ context.Context, req admission.Request) admission.Response {
+	var obj client.Object
+
+	switch req.Kind.Kind {
+	case "DataScienceCluster":
+		dsc := &dscv1.DataScienceCluster{}
+		if err := d.decoder.Decode(req, dsc); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		defaultDSC(dsc)
+		obj = dsc
+	case "DSCInitialization":
+		dsci := &dsciv1.DSCInitialization{}
+		if err := d.decoder.Decode(req, dsci); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		d.defaultDSCI(dsci)
+		obj = dsci
+	default:
+		log.Info("Got wrong kind", "kind", req.Kind.Kind)
+		return admission.Errored(http.StatusBadRequest, nil)
+	}
+
+	defaulted, err := json.Marshal(obj)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	return admission.PatchResponseFromRaw(req.Object.Raw, defaulted)
+}
+
+func defaultDSC(dsc *dscv1.DataScienceCluster) {
+	kserveSpec := &dsc.Spec.Components.Kserve
+	if kserveSpec.ManagementState == operatorv1.Managed {
+		kserveSpec.DefaultDeploymentMode = kserveSpec.EffectiveDeploymentMode()
+	}
+}
+
+func (d *OpenDataHubDefaulter) defaultDSCI(dsci *dsciv1.DSCInitialization) {
+	spec := &dsci.Spec
+	if spec.ApplicationsNamespace == "" {
+		spec.ApplicationsNamespace = d.ApplicationsNamespace
+	}
+	if spec.Monitoring.Namespace == "" {
+		spec.Monitoring.Namespace = d.MonitoringNamespace
+	}
+
+	if spec.ServiceMesh == nil {
+		return
+	}
+	controlPlane := &spec.ServiceMesh.ControlPlane
+	if controlPlane.Name == "" {
+		controlPlane.Name = infrav1.DefaultControlPlaneName
+	}
+	if controlPlane.Namespace == "" {
+		controlPlane.Namespace = infrav1.DefaultControlPlaneNamespace
+	}
+	if controlPlane.MetricsCollection == "" {
+		controlPlane.MetricsCollection = infrav1.DefaultMetricsCollection
+	}
+	if spec.ServiceMesh.Auth.Namespace == "" {
+		spec.ServiceMesh.Auth.Namespace = infrav1.DefaultAuthNamespace(spec.ApplicationsNamespace)
+	}
+}
@@ -0,0 +1,135 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/datasciencecluster/v1"
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+)
+
+//+kubebuilder:webhook:path=/validate-opendatahub-io-v1,mutating=false,failurePolicy=fail,sideEffects=None,groups=dscinitialization.opendatahub.io;features.opendatahub.io,resources=dscinitializations;featuretrackers,verbs=delete,versions=v1,name=delete.operator.opendatahub.io,admissionReviewVersions=v1
+//nolint:lll
+
+// checkDeletion denies deleting objects which others still depend on, listing what has to be removed first, unless the
+// deletion is forced with an annotation.
+func (w *OpenDataHubWebhook) checkDeletion(ctx context.Context, req admission.Request) admission.Response {
+	var obj client.Object
+	var dependents []string
+	var err error
+
+	switch req.Kind.Kind {
+	case "DSCInitialization":
+		dsci := &dsciv1.DSCInitialization{}
+		if err := w.decoder.DecodeRaw(req.OldObject, dsci); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		obj = dsci
+		dependents, err = w.dsciDependents(ctx)
+	case "FeatureTracker":
+		tracker := &featurev1.FeatureTracker{}
+		if err := w.decoder.DecodeRaw(req.OldObject, tracker); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		// the operator removes the features it does not apply anymore, e.g. serverless ones when kserve serving is Removed
+		if w.OperatorUsername != "" && req.UserInfo.Username == w.OperatorUsername {
+			return admission.Allowed("")
+		}
+		obj = tracker
+		dependents, err = w.featureTrackerDependents(ctx, tracker)
+	default:
+		return admission.Allowed("")
+	}
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	if len(dependents) == 0 || obj.GetAnnotations()[annotations.ForceDeletion] == "true" {
+		return admission.Allowed("")
+	}
+
+	return admission.Denied(fmt.Sprintf("%s %s can not be deleted while it is used by %s, remove them first or set the %s annotation "+
+		"to \"true\" to force the deletion", req.Kind.Kind, req.Name, strings.Join(dependents, ", "), annotations.ForceDeletion))
+}
+
+// dsciDependents lists the DataScienceClusters, which can not be reconciled without a DSCInitialization.
+func (w *OpenDataHubWebhook) dsciDependents(ctx context.Context) ([]string, error) {
+	dscs := &dscv1.DataScienceClusterList{}
+	if err := w.client.List(ctx, dscs); err != nil {
+		return nil, err
+	}
+
+	var dependents []string
+	for i := range dscs.Items {
+		dependents = append(dependents, "DataScienceCluster "+dscs.Items[i].Name)
+	}
+
+	return dependents, nil
+}
+
+// featureTrackerDependents lists the objects still enabling the feature of the tracker, which has to be removed by the
+// operator along with the resources it owns.
+func (w *OpenDataHubWebhook) featureTrackerDependents(ctx context.Context, tracker *featurev1.FeatureTracker) ([]string, error) {
+	var dependents []string
+
+	switch tracker.Spec.Source.Type {
+	case featurev1.DSCIType:
+		dsci := &dsciv1.DSCInitialization{}
+		if err := w.client.Get(ctx, client.ObjectKey{Name: tracker.Spec.Source.Name}, dsci); err != nil {
+			if k8serr.IsNotFound(err) {
+				return nil, nil
+			}
+
+			return nil, err
+		}
+		if dsci.DeletionTimestamp.IsZero() {
+			dependents = append(dependents, "DSCInitialization "+dsci.Name)
+		}
+	case featurev1.ComponentType:
+		dscs := &dscv1.DataScienceClusterList{}
+		if err := w.client.List(ctx, dscs); err != nil {
+			return nil, err
+		}
+		// features of components are deployed for the applications namespace of each DataScienceCluster
+		dsc := applicationsNamespaceDSC(dscs.Items, tracker.Spec.AppNamespace)
+		if dsc == nil || !dsc.DeletionTimestamp.IsZero() {
+			return nil, nil
+		}
+		allComponents, err := dsc.GetComponents()
+		if err != nil {
+			return nil, err
+		}
+		for _, component := range allComponents {
+			if component.GetComponentName() == tracker.Spec.Source.Name && component.GetManagementState() == operatorv1.Managed {
+				dependents = append(dependents, "DataScienceCluster "+dsc.Name)
+			}
+		}
+	}
+
+	return dependents, nil
+}
+
+// applicationsNamespaceDSC returns the DataScienceCluster using the applications namespace. Namespaces are not shared,
+// so it is either the one setting the namespace, or the one using the namespace of the DSCInitialization by default.
+func applicationsNamespaceDSC(dscs []dscv1.DataScienceCluster, namespace string) *dscv1.DataScienceCluster {
+	var defaulted *dscv1.DataScienceCluster
+	for i := range dscs {
+		switch dscs[i].Spec.ApplicationsNamespace {
+		case namespace:
+			return &dscs[i]
+		case "":
+			defaulted = &dscs[i]
+		}
+	}
+
+	return defaulted
+}
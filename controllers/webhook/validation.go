@@ -0,0 +1,313 @@
+package webhook
+
+import (
+	"context"
+	"reflect"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/datasciencecluster/v1"
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/components"
+	"github.com/opendatahub-io/opendatahub-operator/v2/components/kserve"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+)
+
+// validateDSC checks the components enabled in the DataScienceCluster can be deployed together, with the existing
+// DSCInitialization if any, and alongside the other DataScienceClusters.
+func (w *OpenDataHubWebhook) validateDSC(ctx context.Context, req admission.Request) (field.ErrorList, error) {
+	dsc := &dscv1.DataScienceCluster{}
+	if err := w.decoder.Decode(req, dsc); err != nil {
+		return nil, err
+	}
+	dscis := &dsciv1.DSCInitializationList{}
+	if err := w.client.List(ctx, dscis); err != nil {
+		return nil, err
+	}
+	dscs := &dscv1.DataScienceClusterList{}
+	if err := w.client.List(ctx, dscs); err != nil {
+		return nil, err
+	}
+
+	var dsci *dsciv1.DSCInitialization
+	if len(dscis.Items) != 0 {
+		dsci = &dscis.Items[0]
+	}
+
+	errs := validateDataScienceCluster(dsc, dsci)
+	errs = append(errs, validateDataScienceClusterSiblings(dsc, dsci, dscs.Items)...)
+	if req.Operation == admissionv1.Update {
+		oldDSC := &dscv1.DataScienceCluster{}
+		if err := w.decoder.DecodeRaw(req.OldObject, oldDSC); err != nil {
+			return nil, err
+		}
+		oldErrs := validateDataScienceCluster(oldDSC, dsci)
+		oldErrs = append(oldErrs, validateDataScienceClusterSiblings(oldDSC, dsci, dscs.Items)...)
+		errs = changedErrors(errs, oldErrs)
+		if dsc.Spec.ApplicationsNamespace != oldDSC.Spec.ApplicationsNamespace {
+			errs = append(errs, field.Forbidden(field.NewPath("spec", "applicationsNamespace"),
+				"field is immutable, applications namespace of DataScienceCluster can not be changed"))
+		}
+	}
+
+	return errs, nil
+}
+
+// validateDSCI checks namespaces of the DSCInitialization, and that it still fulfills requirements of the existing
+// DataScienceClusters if any.
+func (w *OpenDataHubWebhook) validateDSCI(ctx context.Context, req admission.Request) (field.ErrorList, error) {
+	dsci := &dsciv1.DSCInitialization{}
+	if err := w.decoder.Decode(req, dsci); err != nil {
+		return nil, err
+	}
+	dscs := &dscv1.DataScienceClusterList{}
+	if err := w.client.List(ctx, dscs); err != nil {
+		return nil, err
+	}
+
+	errs := validateDSCInitialization(dsci, dscs.Items)
+	if req.Operation == admissionv1.Update {
+		oldDSCI := &dsciv1.DSCInitialization{}
+		if err := w.decoder.DecodeRaw(req.OldObject, oldDSCI); err != nil {
+			return nil, err
+		}
+		errs = changedErrors(errs, validateDSCInitialization(oldDSCI, dscs.Items))
+		errs = append(errs, validateDSCInitializationUpdate(dsci, oldDSCI)...)
+	}
+
+	return errs, nil
+}
+
+// validateComponentDefinition checks the external component can be deployed alongside the built-in ones.
+func (w *OpenDataHubWebhook) validateComponentDefinition(_ context.Context, req admission.Request) (field.ErrorList, error) {
+	definition := &dscv1.ComponentDefinition{}
+	if err := w.decoder.Decode(req, definition); err != nil {
+		return nil, err
+	}
+
+	errs := validateComponentDefinitionSpec(definition)
+	if req.Operation == admissionv1.Update {
+		oldDefinition := &dscv1.ComponentDefinition{}
+		if err := w.decoder.DecodeRaw(req.OldObject, oldDefinition); err != nil {
+			return nil, err
+		}
+		errs = changedErrors(errs, validateComponentDefinitionSpec(oldDefinition))
+		if definition.Spec.TargetNamespace != oldDefinition.Spec.TargetNamespace {
+			errs = append(errs, field.Forbidden(field.NewPath("spec", "targetNamespace"),
+				"field is immutable, target namespace of ComponentDefinition can not be changed"))
+		}
+	}
+
+	return errs, nil
+}
+
+func validateDataScienceCluster(dsc *dscv1.DataScienceCluster, dsci *dsciv1.DSCInitialization) field.ErrorList {
+	var errs field.ErrorList
+	componentsPath := field.NewPath("spec", "components")
+
+	kserveSpec := dsc.Spec.Components.Kserve
+	if kserveSpec.ManagementState == operatorv1.Managed {
+		kservePath := componentsPath.Child("kserve")
+		if kserveSpec.Serving.ManagementState == operatorv1.Removed && kserveSpec.DefaultDeploymentMode == kserve.Serverless {
+			errs = append(errs, field.Invalid(kservePath.Child("defaultDeploymentMode"), kserveSpec.DefaultDeploymentMode,
+				"Serverless deployment mode requires serving not to be Removed, use RawDeployment instead"))
+		}
+		if kserveSpec.Serving.ManagementState == operatorv1.Managed && dsci != nil && !serviceMeshManaged(&dsci.Spec) {
+			errs = append(errs, field.Forbidden(kservePath.Child("serving", "managementState"),
+				"serving requires serviceMesh to be Managed in DSCInitialization "+dsci.Name))
+		}
+		if dsc.Spec.Components.ModelMeshServing.ManagementState == operatorv1.Managed {
+			errs = append(errs, field.Forbidden(componentsPath.Child("modelmeshserving", "managementState"),
+				"modelmeshserving can not be Managed at the same time as kserve, set one of them to Removed"))
+		}
+	}
+
+	// Errors are reported under the field of each registered component
+	for _, registration := range components.Registrations() {
+		if component := dsc.Spec.Components.Component(registration.Field); component != nil {
+			errs = append(errs, validateDevFlags(componentsPath.Child(registration.Field, "devFlags"), component.GetDevFlags())...)
+			errs = append(errs, validatePreservedFields(componentsPath.Child(registration.Field, "preservedFields"),
+				component.GetCommon().PreservedFields)...)
+		}
+	}
+
+	return errs
+}
+
+// validateDataScienceClusterSiblings checks the DataScienceCluster can be deployed alongside the other ones: each needs
+// its own applications namespace, and the KNative-Serving stack of kserve can only be configured by one of them.
+func validateDataScienceClusterSiblings(dsc *dscv1.DataScienceCluster, dsci *dsciv1.DSCInitialization, dscs []dscv1.DataScienceCluster) field.ErrorList {
+	var errs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	if dsc.Spec.ApplicationsNamespace != "" {
+		errs = append(errs, validateNamespace(specPath.Child("applicationsNamespace"), dsc.Spec.ApplicationsNamespace)...)
+	}
+	dsciNamespace := ""
+	if dsci != nil {
+		dsciNamespace = dsci.Spec.ApplicationsNamespace
+	}
+	namespace := dsc.GetApplicationsNamespace(dsciNamespace)
+	for i := range dscs {
+		other := &dscs[i]
+		if other.Name == dsc.Name {
+			continue
+		}
+		if other.GetApplicationsNamespace(dsciNamespace) == namespace {
+			errs = append(errs, field.Forbidden(specPath.Child("applicationsNamespace"),
+				"applications namespace "+namespace+" is already used by DataScienceCluster "+other.Name+", set another one"))
+		}
+		if servingManaged(dsc) && servingManaged(other) {
+			errs = append(errs, field.Forbidden(specPath.Child("components", "kserve", "serving", "managementState"),
+				"serving is already Managed in DataScienceCluster "+other.Name+", it can only be configured by one DataScienceCluster"))
+		}
+	}
+
+	return errs
+}
+
+func validateDSCInitialization(dsci *dsciv1.DSCInitialization, dscs []dscv1.DataScienceCluster) field.ErrorList {
+	var errs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	errs = append(errs, validateNamespace(specPath.Child("applicationsNamespace"), dsci.Spec.ApplicationsNamespace)...)
+	errs = append(errs, validatePreservedFields(specPath.Child("preservedFields"), dsci.Spec.PreservedFields)...)
+	if dsci.Spec.Monitoring.Namespace != "" {
+		errs = append(errs, validateNamespace(specPath.Child("monitoring", "namespace"), dsci.Spec.Monitoring.Namespace)...)
+	}
+	if serviceMesh := dsci.Spec.ServiceMesh; serviceMesh != nil {
+		serviceMeshPath := specPath.Child("serviceMesh")
+		if serviceMesh.ControlPlane.Namespace != "" {
+			errs = append(errs, validateNamespace(serviceMeshPath.Child("controlPlane", "namespace"), serviceMesh.ControlPlane.Namespace)...)
+		}
+		if serviceMesh.Auth.Namespace != "" {
+			errs = append(errs, validateNamespace(serviceMeshPath.Child("auth", "namespace"), serviceMesh.Auth.Namespace)...)
+		}
+	}
+
+	for i := range dscs {
+		if !serviceMeshManaged(&dsci.Spec) && servingManaged(&dscs[i]) {
+			errs = append(errs, field.Forbidden(specPath.Child("serviceMesh", "managementState"),
+				"serviceMesh has to be Managed as long as kserve serving is Managed in DataScienceCluster "+dscs[i].Name))
+		}
+	}
+
+	return errs
+}
+
+func validateComponentDefinitionSpec(definition *dscv1.ComponentDefinition) field.ErrorList {
+	var errs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	// Components are reported in the status of DataScienceClusters by name
+	if _, registered := components.Lookup(definition.Name); registered {
+		errs = append(errs, field.Invalid(field.NewPath("metadata", "name"), definition.Name,
+			"name of a built-in component, choose another name for the external component"))
+	}
+	if err := deploy.ValidateManifestsURI(definition.Spec.Manifests.URI); err != nil {
+		errs = append(errs, field.Invalid(specPath.Child("manifests", "uri"), definition.Spec.Manifests.URI, err.Error()))
+	}
+	errs = append(errs, validateDevFlags(specPath.Child("devFlags"), definition.Spec.DevFlags)...)
+	if definition.Spec.TargetNamespace != "" {
+		errs = append(errs, validateNamespace(specPath.Child("targetNamespace"), definition.Spec.TargetNamespace)...)
+	}
+	for i, dependency := range definition.Spec.Dependencies {
+		if dependency == definition.Name {
+			errs = append(errs, field.Invalid(specPath.Child("dependencies").Index(i), dependency, "component can not depend on itself"))
+		}
+	}
+
+	return errs
+}
+
+// validateDSCInitializationUpdate prevents changes of namespaces holding the objects deployed by the operator, which
+// would be left behind. The monitoring namespace can be changed when a migration is requested with an annotation.
+func validateDSCInitializationUpdate(dsci, oldDSCI *dsciv1.DSCInitialization) field.ErrorList {
+	var errs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	if oldDSCI.Spec.ApplicationsNamespace != "" && dsci.Spec.ApplicationsNamespace != oldDSCI.Spec.ApplicationsNamespace {
+		errs = append(errs, field.Forbidden(specPath.Child("applicationsNamespace"),
+			"field is immutable, applications namespace "+oldDSCI.Spec.ApplicationsNamespace+" can not be changed"))
+	}
+	if oldDSCI.Spec.Monitoring.Namespace != "" && dsci.Spec.Monitoring.Namespace != oldDSCI.Spec.Monitoring.Namespace &&
+		dsci.GetAnnotations()[annotations.MigrateMonitoringNamespace] != "true" {
+		errs = append(errs, field.Forbidden(specPath.Child("monitoring", "namespace"),
+			"monitoring namespace "+oldDSCI.Spec.Monitoring.Namespace+" can only be changed with the "+
+				annotations.MigrateMonitoringNamespace+" annotation set to \"true\", to migrate the monitoring stack"))
+	}
+
+	return errs
+}
+
+func validateDevFlags(devFlagsPath *field.Path, devFlags *components.DevFlags) field.ErrorList {
+	var errs field.ErrorList
+	if devFlags == nil {
+		return errs
+	}
+	for i, manifestsConfig := range devFlags.Manifests {
+		if err := deploy.ValidateManifestsURI(manifestsConfig.URI); err != nil {
+			errs = append(errs, field.Invalid(devFlagsPath.Child("manifests").Index(i).Child("uri"), manifestsConfig.URI, err.Error()))
+		}
+	}
+
+	return errs
+}
+
+func validatePreservedFields(policiesPath *field.Path, policies []infrav1.FieldPreservationPolicy) field.ErrorList {
+	var errs field.ErrorList
+	for i, policy := range policies {
+		for j, path := range policy.Paths {
+			if err := deploy.ValidatePreservedPath(path); err != nil {
+				errs = append(errs, field.Invalid(policiesPath.Index(i).Child("paths").Index(j), path, err.Error()))
+			}
+		}
+	}
+
+	return errs
+}
+
+func validateNamespace(namespacePath *field.Path, namespace string) field.ErrorList {
+	var errs field.ErrorList
+	for _, msg := range validation.IsDNS1123Label(namespace) {
+		errs = append(errs, field.Invalid(namespacePath, namespace, msg))
+	}
+
+	return errs
+}
+
+// changedErrors drops the errors already reported for the previous version of an object, so that updates are only
+// rejected for the invalid fields they change, and objects which became invalid, e.g. with a DataScienceCluster
+// created afterwards, can still be updated.
+func changedErrors(errs, oldErrs field.ErrorList) field.ErrorList {
+	var changed field.ErrorList
+	for _, err := range errs {
+		reported := false
+		for _, oldErr := range oldErrs {
+			if oldErr.Type == err.Type && oldErr.Field == err.Field && reflect.DeepEqual(oldErr.BadValue, err.BadValue) {
+				reported = true
+
+				break
+			}
+		}
+		if !reported {
+			changed = append(changed, err)
+		}
+	}
+
+	return changed
+}
+
+func serviceMeshManaged(dsciSpec *dsciv1.DSCInitializationSpec) bool {
+	return dsciSpec.ServiceMesh != nil && dsciSpec.ServiceMesh.ManagementState == operatorv1.Managed
+}
+
+func servingManaged(dsc *dscv1.DataScienceCluster) bool {
+	return dsc.Spec.Components.Kserve.ManagementState == operatorv1.Managed && dsc.Spec.Components.Kserve.Serving.ManagementState == operatorv1.Managed
+}
@@ -22,8 +22,11 @@ import (
 	"net/http"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
@@ -32,10 +35,14 @@ import (
 
 var log = ctrl.Log.WithName("odh-controller-webhook")
 
-//+kubebuilder:webhook:path=/validate-opendatahub-io-v1,mutating=false,failurePolicy=fail,sideEffects=None,groups=datasciencecluster.opendatahub.io;dscinitialization.opendatahub.io,resources=datascienceclusters;dscinitializations,verbs=create;update,versions=v1,name=operator.opendatahub.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-opendatahub-io-v1,mutating=false,failurePolicy=fail,sideEffects=None,groups=datasciencecluster.opendatahub.io;dscinitialization.opendatahub.io,resources=datascienceclusters;dscinitializations;componentdefinitions,verbs=create;update,versions=v1,name=operator.opendatahub.io,admissionReviewVersions=v1
 //nolint:lll
 
 type OpenDataHubWebhook struct {
+	// OperatorUsername is the user of the operator, which deletes the FeatureTrackers of the features it does not
+	// apply anymore, while the objects they belong to still exist.
+	OperatorUsername string
+
 	client  client.Client
 	decoder *admission.Decoder
 }
@@ -82,9 +89,13 @@ func denyCountGtZero(ctx context.Context, cli client.Client, gvk schema.GroupVer
 	return admission.Allowed("")
 }
 
+// checkDupCreation denies creating a second DSCInitialization. Several DataScienceClusters can be created, as long as
+// each uses its own applications namespace, which is validated with the rest of their spec.
 func (w *OpenDataHubWebhook) checkDupCreation(ctx context.Context, req admission.Request) admission.Response {
 	switch req.Kind.Kind {
-	case "DataScienceCluster", "DSCInitialization":
+	case "DataScienceCluster", "ComponentDefinition":
+		return admission.Allowed("")
+	case "DSCInitialization":
 	default:
 		log.Info("Got wrong kind", "kind", req.Kind.Kind)
 		return admission.Errored(http.StatusBadRequest, nil)
@@ -101,12 +112,64 @@ func (w *OpenDataHubWebhook) checkDupCreation(ctx context.Context, req admission
 		fmt.Sprintf("Only one instance of %s object is allowed", req.Kind.Kind))
 }
 
+// validate rejects specs which can not be reconciled, reporting the path of each invalid field. Updates which do not
+// change the spec, such as finalizer updates, and updates of objects being deleted are not validated.
+func (w *OpenDataHubWebhook) validate(ctx context.Context, req admission.Request) admission.Response {
+	var errs field.ErrorList
+	var err error
+
+	if req.Operation == admissionv1.Update {
+		obj, oldObj := &unstructured.Unstructured{}, &unstructured.Unstructured{}
+		if err = w.decoder.DecodeRaw(req.Object, obj); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		if err = w.decoder.DecodeRaw(req.OldObject, oldObj); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		if obj.GetDeletionTimestamp() != nil || equality.Semantic.DeepEqual(obj.Object["spec"], oldObj.Object["spec"]) {
+			return admission.Allowed("")
+		}
+	}
+
+	switch req.Kind.Kind {
+	case "DataScienceCluster":
+		errs, err = w.validateDSC(ctx, req)
+	case "DSCInitialization":
+		errs, err = w.validateDSCI(ctx, req)
+	case "ComponentDefinition":
+		errs, err = w.validateComponentDefinition(ctx, req)
+	default:
+		log.Info("Got wrong kind", "kind", req.Kind.Kind)
+		return admission.Errored(http.StatusBadRequest, nil)
+	}
+	if err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if len(errs) != 0 {
+		invalidErr := k8serr.NewInvalid(schema.GroupKind{Group: req.Kind.Group, Kind: req.Kind.Kind}, req.Name, errs)
+		resp := admission.Denied(invalidErr.Error())
+		resp.Result = &invalidErr.ErrStatus
+
+		return resp
+	}
+
+	return admission.Allowed("")
+}
+
 func (w *OpenDataHubWebhook) Handle(ctx context.Context, req admission.Request) admission.Response {
 	var resp admission.Response
 
 	switch req.Operation {
 	case admissionv1.Create:
 		resp = w.checkDupCreation(ctx, req)
+		if resp.Allowed {
+			resp = w.validate(ctx, req)
+		}
+	case admissionv1.Update:
+		resp = w.validate(ctx, req)
+	case admissionv1.Delete:
+		resp = w.checkDeletion(ctx, req)
 	default:
 		msg := fmt.Sprintf("No logic check by webhook is applied on %v request", req.Operation)
 		log.Info(msg)
@@ -37,7 +37,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
 	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/datasciencecluster/v1"
+	dscv2 "github.com/opendatahub-io/opendatahub-operator/v2/apis/datasciencecluster/v2"
 	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/components"
 	"github.com/opendatahub-io/opendatahub-operator/v2/components/codeflare"
 	"github.com/opendatahub-io/opendatahub-operator/v2/components/dashboard"
@@ -48,14 +51,16 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/components/trustyai"
 	"github.com/opendatahub-io/opendatahub-operator/v2/components/workbenches"
 	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/webhook"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
 
 const (
-	namespace = "webhook-test-ns"
-	nameBase  = "webhook-test"
+	namespace    = "webhook-test-ns"
+	nameBase     = "webhook-test"
+	operatorUser = "webhook-test-operator"
 )
 
 // These tests use Ginkgo (BDD-style Go testing framework). Refer to
@@ -63,6 +68,7 @@ const (
 
 var cfg *rest.Config
 var k8sClient client.Client
+var operatorClient client.Client
 var testEnv *envtest.Environment
 var gCtx context.Context
 var gCancel context.CancelFunc
@@ -79,8 +85,26 @@ var _ = BeforeSuite(func() {
 
 	logf.SetLogger(zap.New(zap.WriteTo(GinkgoWriter), zap.UseDevMode(true)))
 
+	scheme := runtime.NewScheme()
+	// DSCI
+	err := dsciv1.AddToScheme(scheme)
+	Expect(err).NotTo(HaveOccurred())
+	// DSC
+	err = dscv1.AddToScheme(scheme)
+	Expect(err).NotTo(HaveOccurred())
+	err = dscv2.AddToScheme(scheme)
+	Expect(err).NotTo(HaveOccurred())
+	// FeatureTracker
+	err = featurev1.AddToScheme(scheme)
+	Expect(err).NotTo(HaveOccurred())
+	// Webhook
+	err = admissionv1beta1.AddToScheme(scheme)
+	Expect(err).NotTo(HaveOccurred())
+
 	By("bootstrapping test environment")
 	testEnv = &envtest.Environment{
+		// the scheme enables the conversion webhook of the CRDs with several versions
+		Scheme:                scheme,
 		CRDDirectoryPaths:     []string{filepath.Join("..", "..", "config", "crd", "bases")},
 		ErrorIfCRDPathMissing: false,
 		WebhookInstallOptions: envtest.WebhookInstallOptions{
@@ -88,27 +112,20 @@ var _ = BeforeSuite(func() {
 		},
 	}
 
-	var err error
 	// cfg is defined in this file globally.
 	cfg, err = testEnv.Start()
 	Expect(err).NotTo(HaveOccurred())
 	Expect(cfg).NotTo(BeNil())
 
-	scheme := runtime.NewScheme()
-	// DSCI
-	err = dsciv1.AddToScheme(scheme)
-	Expect(err).NotTo(HaveOccurred())
-	// DSC
-	err = dscv1.AddToScheme(scheme)
-	Expect(err).NotTo(HaveOccurred())
-	// Webhook
-	err = admissionv1beta1.AddToScheme(scheme)
-	Expect(err).NotTo(HaveOccurred())
-
 	k8sClient, err = client.New(cfg, client.Options{Scheme: scheme})
 	Expect(err).NotTo(HaveOccurred())
 	Expect(k8sClient).NotTo(BeNil())
 
+	operator, err := testEnv.AddUser(envtest.User{Name: operatorUser, Groups: []string{"system:masters"}}, cfg)
+	Expect(err).NotTo(HaveOccurred())
+	operatorClient, err = client.New(operator.Config(), client.Options{Scheme: scheme})
+	Expect(err).NotTo(HaveOccurred())
+
 	// start webhook server using Manager
 	webhookInstallOptions := &testEnv.WebhookInstallOptions
 	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
@@ -121,7 +138,10 @@ var _ = BeforeSuite(func() {
 	})
 	Expect(err).NotTo(HaveOccurred())
 
-	(&webhook.OpenDataHubWebhook{}).SetupWithManager(mgr)
+	(&webhook.OpenDataHubWebhook{OperatorUsername: operatorUser}).SetupWithManager(mgr)
+	(&webhook.OpenDataHubDefaulter{ApplicationsNamespace: namespace, MonitoringNamespace: namespace}).SetupWithManager(mgr)
+	err = (&dscv1.DataScienceCluster{}).SetupWebhookWithManager(mgr)
+	Expect(err).NotTo(HaveOccurred())
 
 	// +kubebuilder:scaffold:webhook
 
@@ -167,6 +187,211 @@ var _ = Describe("DSC/DSCI webhook", func() {
 		dscSpec = newDSC(nameBase+"-dsc-2", namespace)
 		Expect(k8sClient.Create(ctx, dscSpec)).ShouldNot(Succeed())
 	})
+
+	It("Should allow DSCs with distinct applications namespaces", func(ctx context.Context) {
+		dscSpec := newDSC(nameBase+"-dsc-2", namespace)
+		dscSpec.Spec.ApplicationsNamespace = namespace + "-2"
+		Expect(k8sClient.Create(ctx, dscSpec)).Should(Succeed())
+
+		dscSpec3 := newDSC(nameBase+"-dsc-3", namespace)
+		dscSpec3.Spec.ApplicationsNamespace = namespace + "-2"
+		err := k8sClient.Create(ctx, dscSpec3)
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).Should(ContainSubstring("spec.applicationsNamespace"))
+
+		dscSpec.Spec.ApplicationsNamespace = namespace + "-3"
+		err = k8sClient.Update(ctx, dscSpec)
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).Should(ContainSubstring("spec.applicationsNamespace"))
+
+		Expect(k8sClient.Delete(ctx, dscSpec)).Should(Succeed())
+	})
+
+	It("Should reject invalid DSC specs with the path of the invalid field", func(ctx context.Context) {
+		dscSpec := newDSC(nameBase+"-dsc-1", namespace)
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(dscSpec), dscSpec)).Should(Succeed())
+		dscSpec.Spec.Components.Kserve.ManagementState = operatorv1.Managed
+		dscSpec.Spec.Components.Kserve.Serving.ManagementState = operatorv1.Removed
+		dscSpec.Spec.Components.Kserve.DefaultDeploymentMode = kserve.Serverless
+		err := k8sClient.Update(ctx, dscSpec)
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).Should(ContainSubstring("spec.components.kserve.defaultDeploymentMode"))
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(dscSpec), dscSpec)).Should(Succeed())
+		dscSpec.Spec.Components.Dashboard.DevFlags = &components.DevFlags{
+			Manifests: []components.ManifestsConfig{{URI: "ftp://example.com/dashboard.tar.gz"}},
+		}
+		err = k8sClient.Update(ctx, dscSpec)
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).Should(ContainSubstring("spec.components.dashboard.devFlags.manifests[0].uri"))
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(dscSpec), dscSpec)).Should(Succeed())
+		dscSpec.Spec.Components.Dashboard.PreservedFields = []infrav1.FieldPreservationPolicy{
+			{Group: "apps", Kind: "Deployment", Paths: []string{".spec.replicas", "spec.template"}},
+		}
+		err = k8sClient.Update(ctx, dscSpec)
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).Should(ContainSubstring("spec.components.dashboard.preservedFields[0].paths[1]"))
+	})
+
+	It("Should validate ComponentDefinitions", func(ctx context.Context) {
+		definition := &dscv1.ComponentDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: dashboard.ComponentName},
+			Spec: dscv1.ComponentDefinitionSpec{
+				Manifests: components.ManifestsConfig{URI: "configmap://" + namespace + "/custom-runtimes"},
+			},
+		}
+		err := k8sClient.Create(ctx, definition)
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).Should(ContainSubstring("metadata.name"))
+
+		definition.Name = nameBase + "-custom-runtimes"
+		definition.Spec.Manifests.URI = "configmap://" + namespace
+		err = k8sClient.Create(ctx, definition)
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).Should(ContainSubstring("spec.manifests.uri"))
+
+		definition.Spec.Manifests.URI = "configmap://" + namespace + "/custom-runtimes"
+		Expect(k8sClient.Create(ctx, definition)).Should(Succeed())
+		definition.Spec.TargetNamespace = "custom-runtimes"
+		err = k8sClient.Update(ctx, definition)
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).Should(ContainSubstring("spec.targetNamespace"))
+		Expect(k8sClient.Delete(ctx, definition)).Should(Succeed())
+	})
+
+	It("Should reject DSCI with invalid namespaces", func(ctx context.Context) {
+		desiredDsci := newDSCI(nameBase + "-dsci-1")
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(desiredDsci), desiredDsci)).Should(Succeed())
+		desiredDsci.Spec.Monitoring.Namespace = "Invalid_Namespace"
+		err := k8sClient.Update(ctx, desiredDsci)
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).Should(ContainSubstring("spec.monitoring.namespace"))
+	})
+
+	It("Should reject DSCI with invalid preserved fields", func(ctx context.Context) {
+		desiredDsci := newDSCI(nameBase + "-dsci-1")
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(desiredDsci), desiredDsci)).Should(Succeed())
+		desiredDsci.Spec.PreservedFields = []infrav1.FieldPreservationPolicy{
+			{Group: "apps", Kind: "Deployment", Paths: []string{".spec.template.spec.containers[0].resources"}},
+		}
+		err := k8sClient.Update(ctx, desiredDsci)
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).Should(ContainSubstring("spec.preservedFields[0].paths[0]"))
+	})
+
+	It("Should set defaults of DSCI and DSC", func(ctx context.Context) {
+		desiredDsci := newDSCI(nameBase + "-dsci-1")
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(desiredDsci), desiredDsci)).Should(Succeed())
+		desiredDsci.Spec.ServiceMesh = &infrav1.ServiceMeshSpec{ManagementState: operatorv1.Removed}
+		Expect(k8sClient.Update(ctx, desiredDsci)).Should(Succeed())
+		Expect(desiredDsci.Spec.ServiceMesh.ControlPlane.Name).Should(Equal(infrav1.DefaultControlPlaneName))
+		Expect(desiredDsci.Spec.ServiceMesh.ControlPlane.Namespace).Should(Equal(infrav1.DefaultControlPlaneNamespace))
+		Expect(desiredDsci.Spec.ServiceMesh.Auth.Namespace).Should(Equal(namespace + "-auth-provider"))
+
+		dscSpec := newDSC(nameBase+"-dsc-1", namespace)
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(dscSpec), dscSpec)).Should(Succeed())
+		dscSpec.Spec.Components.Kserve.ManagementState = operatorv1.Managed
+		dscSpec.Spec.Components.Kserve.Serving.ManagementState = operatorv1.Removed
+		dscSpec.Spec.Components.Kserve.DefaultDeploymentMode = ""
+		Expect(k8sClient.Update(ctx, dscSpec)).Should(Succeed())
+		Expect(dscSpec.Spec.Components.Kserve.DefaultDeploymentMode).Should(Equal(kserve.RawDeployment))
+	})
+
+	It("Should only validate the spec fields changed by updates", func(ctx context.Context) {
+		dscSpec := newDSC(nameBase+"-dsc-1", namespace)
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(dscSpec), dscSpec)).Should(Succeed())
+		dscSpec.Spec.Components.ModelMeshServing.ManagementState = operatorv1.Managed
+		err := k8sClient.Update(ctx, dscSpec)
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).Should(ContainSubstring("spec.components.modelmeshserving.managementState"))
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(dscSpec), dscSpec)).Should(Succeed())
+		dscSpec.Spec.Components.Kserve.Serving.ManagementState = operatorv1.Managed
+		err = k8sClient.Update(ctx, dscSpec)
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).Should(ContainSubstring("spec.components.kserve.serving.managementState"))
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(dscSpec), dscSpec)).Should(Succeed())
+		dscSpec.SetFinalizers([]string{"datasciencecluster.opendatahub.io/finalizer"})
+		Expect(k8sClient.Update(ctx, dscSpec)).Should(Succeed())
+		dscSpec.SetFinalizers(nil)
+		Expect(k8sClient.Update(ctx, dscSpec)).Should(Succeed())
+	})
+
+	It("Should only allow a migration of the monitoring namespace of DSCI", func(ctx context.Context) {
+		desiredDsci := newDSCI(nameBase + "-dsci-1")
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(desiredDsci), desiredDsci)).Should(Succeed())
+		desiredDsci.Spec.ApplicationsNamespace = "other-applications-namespace"
+		err := k8sClient.Update(ctx, desiredDsci)
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).Should(ContainSubstring("spec.applicationsNamespace"))
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(desiredDsci), desiredDsci)).Should(Succeed())
+		desiredDsci.Spec.Monitoring.Namespace = "other-monitoring-namespace"
+		err = k8sClient.Update(ctx, desiredDsci)
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).Should(ContainSubstring("spec.monitoring.namespace"))
+
+		desiredDsci.SetAnnotations(map[string]string{annotations.MigrateMonitoringNamespace: "true"})
+		Expect(k8sClient.Update(ctx, desiredDsci)).Should(Succeed())
+	})
+
+	It("Should convert the component config of DSC between v1 and v2", func(ctx context.Context) {
+		replicas := int32(2)
+		dscV2 := &dscv2.DataScienceCluster{}
+		Expect(k8sClient.Get(ctx, client.ObjectKey{Name: nameBase + "-dsc-1"}, dscV2)).Should(Succeed())
+		dscV2.Spec.Components.Dashboard.Replicas = &replicas
+		Expect(k8sClient.Update(ctx, dscV2)).Should(Succeed())
+
+		dscV1 := &dscv1.DataScienceCluster{}
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(dscV2), dscV1)).Should(Succeed())
+		config, err := dscV1.ComponentConfig(dashboard.ComponentName)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.Replicas).Should(Equal(&replicas))
+
+		dscV2 = &dscv2.DataScienceCluster{}
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(dscV1), dscV2)).Should(Succeed())
+		Expect(dscV2.Spec.Components.Dashboard.Replicas).Should(Equal(&replicas))
+		Expect(dscV2.GetAnnotations()).ShouldNot(HaveKey(annotations.ComponentsConfig))
+	})
+
+	It("Should only let the operator delete FeatureTrackers of enabled features", func(ctx context.Context) {
+		dsciTracker := featurev1.NewFeatureTracker("mesh-control-plane-creation", namespace)
+		dsciTracker.Spec.Source = featurev1.Source{Type: featurev1.DSCIType, Name: nameBase + "-dsci-1"}
+		Expect(k8sClient.Create(ctx, dsciTracker)).Should(Succeed())
+		err := k8sClient.Delete(ctx, dsciTracker)
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).Should(ContainSubstring("DSCInitialization " + nameBase + "-dsci-1"))
+		Expect(operatorClient.Delete(ctx, dsciTracker)).Should(Succeed())
+
+		componentTracker := featurev1.NewFeatureTracker("serverless-serving-deployment", namespace)
+		componentTracker.Spec.Source = featurev1.Source{Type: featurev1.ComponentType, Name: kserve.ComponentName}
+		componentTracker.Spec.AppNamespace = namespace
+		Expect(k8sClient.Create(ctx, componentTracker)).Should(Succeed())
+		err = k8sClient.Delete(ctx, componentTracker)
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).Should(ContainSubstring("DataScienceCluster " + nameBase + "-dsc-1"))
+		Expect(operatorClient.Delete(ctx, componentTracker)).Should(Succeed())
+
+		removedComponentTracker := featurev1.NewFeatureTracker("dashboard-feature", namespace)
+		removedComponentTracker.Spec.Source = featurev1.Source{Type: featurev1.ComponentType, Name: dashboard.ComponentName}
+		removedComponentTracker.Spec.AppNamespace = namespace
+		Expect(k8sClient.Create(ctx, removedComponentTracker)).Should(Succeed())
+		Expect(k8sClient.Delete(ctx, removedComponentTracker)).Should(Succeed())
+	})
+
+	It("Should block deletion of DSCI while a DSC exists unless forced", func(ctx context.Context) {
+		desiredDsci := newDSCI(nameBase + "-dsci-1")
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(desiredDsci), desiredDsci)).Should(Succeed())
+		err := k8sClient.Delete(ctx, desiredDsci)
+		Expect(err).Should(HaveOccurred())
+		Expect(err.Error()).Should(ContainSubstring("DataScienceCluster " + nameBase + "-dsc-1"))
+
+		desiredDsci.SetAnnotations(map[string]string{annotations.ForceDeletion: "true"})
+		Expect(k8sClient.Update(ctx, desiredDsci)).Should(Succeed())
+		Expect(k8sClient.Delete(ctx, desiredDsci)).Should(Succeed())
+	})
 })
 
 func newDSCI(appName string) *dsciv1.DSCInitialization {
@@ -20,6 +20,8 @@ import (
 	"context"
 	"flag"
 	"os"
+	"path/filepath"
+	"time"
 
 	addonv1alpha1 "github.com/openshift/addon-operator/apis/addons/v1alpha1"
 	ocappsv1 "github.com/openshift/api/apps/v1" //nolint:importas //reason: conflicts with appsv1 "k8s.io/api/apps/v1"
@@ -51,14 +53,17 @@ import (
 
 	kfdefv1 "github.com/opendatahub-io/opendatahub-operator/apis/kfdef.apps.kubeflow.org/v1"
 	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/datasciencecluster/v1"
+	dscv2 "github.com/opendatahub-io/opendatahub-operator/v2/apis/datasciencecluster/v2"
 	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
 	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/certconfigmapgenerator"
 	datascienceclustercontrollers "github.com/opendatahub-io/opendatahub-operator/v2/controllers/datasciencecluster"
 	dscicontr "github.com/opendatahub-io/opendatahub-operator/v2/controllers/dscinitialization"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/featuretracker"
 	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/secretgenerator"
 	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/webhook"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/logger"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/upgrade"
 )
@@ -75,6 +80,7 @@ func init() { //nolint:gochecknoinits
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(dsciv1.AddToScheme(scheme))
 	utilruntime.Must(dscv1.AddToScheme(scheme))
+	utilruntime.Must(dscv2.AddToScheme(scheme))
 	utilruntime.Must(featurev1.AddToScheme(scheme))
 	utilruntime.Must(networkingv1.AddToScheme(scheme))
 	utilruntime.Must(addonv1alpha1.AddToScheme(scheme))
@@ -105,6 +111,8 @@ func main() { //nolint:funlen
 	var dscMonitoringNamespace string
 	var operatorName string
 	var logmode string
+	var maxConcurrentComponentReconciles int
+	var manifestsCacheMaxAge time.Duration
 
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
@@ -114,6 +122,10 @@ func main() { //nolint:funlen
 		"monitoring stack will be deployed")
 	flag.StringVar(&operatorName, "operator-name", "opendatahub", "The name of the operator")
 	flag.StringVar(&logmode, "log-mode", "", "Log mode ('', prod, devel), default to ''")
+	flag.IntVar(&maxConcurrentComponentReconciles, "max-concurrent-component-reconciles", 4, "The maximum number of components "+
+		"reconciled in parallel by the data science cluster controller")
+	flag.DurationVar(&manifestsCacheMaxAge, "manifests-cache-max-age", deploy.DefaultManifestsCacheMaxAge, "How long manifests "+
+		"archives downloaded for devFlags are cached once they are not used anymore")
 
 	flag.Parse()
 
@@ -133,7 +145,19 @@ func main() { //nolint:funlen
 		os.Exit(1)
 	}
 
-	(&webhook.OpenDataHubWebhook{}).SetupWithManager(mgr)
+	operatorUsername, err := cluster.GetOperatorUsername()
+	if err != nil {
+		setupLog.Info("FeatureTrackers of enabled features can only be deleted when forced", "reason", err.Error())
+	}
+	(&webhook.OpenDataHubWebhook{OperatorUsername: operatorUsername}).SetupWithManager(mgr)
+	(&webhook.OpenDataHubDefaulter{
+		ApplicationsNamespace: dscApplicationsNamespace,
+		MonitoringNamespace:   dscMonitoringNamespace,
+	}).SetupWithManager(mgr)
+	if err = (&dscv1.DataScienceCluster{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create conversion webhook", "webhook", "DataScienceCluster")
+		os.Exit(1)
+	}
 
 	if err = (&dscicontr.DSCInitializationReconciler{
 		Client:                mgr.GetClient(),
@@ -155,7 +179,9 @@ func main() { //nolint:funlen
 				ApplicationsNamespace: dscApplicationsNamespace,
 			},
 		},
-		Recorder: mgr.GetEventRecorderFor("datasciencecluster-controller"),
+		Recorder:                         mgr.GetEventRecorderFor("datasciencecluster-controller"),
+		MaxConcurrentComponentReconciles: maxConcurrentComponentReconciles,
+		ManifestsCache:                   deploy.NewManifestsCache(filepath.Join(os.TempDir(), "odh-manifests-cache"), manifestsCacheMaxAge),
 	}).SetupWithManager(ctx, mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "DataScienceCluster")
 		os.Exit(1)
@@ -179,6 +205,15 @@ func main() { //nolint:funlen
 		os.Exit(1)
 	}
 
+	if err = (&featuretracker.FeatureTrackerReconciler{
+		Client:   mgr.GetClient(),
+		Log:      logger.LogWithLevel(ctrl.Log.WithName(operatorName).WithName("controllers").WithName("FeatureTracker"), logmode),
+		Recorder: mgr.GetEventRecorderFor("featuretracker-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "FeatureTracker")
+		os.Exit(1)
+	}
+
 	// Create new uncached client to run initial setup
 	setupCfg, err := config.GetConfig()
 	if err != nil {
@@ -0,0 +1,359 @@
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlLog "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/fieldpath"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+)
+
+// FieldManager is the field manager used by the operator for all server-side apply requests.
+const FieldManager = "opendatahub-operator"
+
+// ConflictResolution tells how a conflict with another field manager has been resolved.
+type ConflictResolution string
+
+const (
+	// ConflictForced means the operator took over the conflicting fields.
+	ConflictForced ConflictResolution = "Forced"
+	// ConflictRetained means the conflicting fields kept the values set by other managers,
+	// as the object is annotated with annotations.RespectUserEdits.
+	ConflictRetained ConflictResolution = "Retained"
+)
+
+// Conflict describes fields of an object which are managed by other field managers and have been changed by them.
+type Conflict struct {
+	Object     corev1.ObjectReference
+	Managers   []string
+	Fields     []string
+	Resolution ConflictResolution
+}
+
+func (c Conflict) String() string {
+	return fmt.Sprintf("%s %s/%s: %s managed by %s (%s)", c.Object.Kind, c.Object.Namespace, c.Object.Name,
+		strings.Join(c.Fields, ", "), strings.Join(c.Managers, ", "), c.Resolution)
+}
+
+type conflictsKey struct{}
+
+// Conflicts collects conflicts encountered by Apply. It is attached to the context using WithConflicts,
+// so callers such as the DataScienceCluster controller can report them for each component.
+type Conflicts struct {
+	mu        sync.Mutex
+	conflicts []Conflict
+}
+
+func NewConflicts() *Conflicts {
+	return &Conflicts{}
+}
+
+// WithConflicts returns a context which makes Apply record conflicts in the given collection.
+func WithConflicts(ctx context.Context, conflicts *Conflicts) context.Context {
+	return context.WithValue(ctx, conflictsKey{}, conflicts)
+}
+
+func conflictsFrom(ctx context.Context) *Conflicts {
+	conflicts, _ := ctx.Value(conflictsKey{}).(*Conflicts)
+
+	return conflicts
+}
+
+// List returns all recorded conflicts, in the order they have been encountered.
+func (c *Conflicts) List() []Conflict {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]Conflict(nil), c.conflicts...)
+}
+
+func (c *Conflicts) record(conflict Conflict) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.conflicts = append(c.conflicts, conflict)
+}
+
+// ApplyOption customizes how Apply resolves conflicts.
+type ApplyOption func(*applyOptions)
+
+type applyOptions struct {
+	takeOverFrom map[string]bool
+}
+
+// TakeOverFrom makes Apply take over fields managed by the given field managers without reporting conflicts.
+// It is meant for managers used by the operator itself before FieldManager was introduced.
+func TakeOverFrom(managers ...string) ApplyOption {
+	return func(o *applyOptions) {
+		for _, manager := range managers {
+			o.takeOverFrom[manager] = true
+		}
+	}
+}
+
+// Apply creates or updates the object using server-side apply with FieldManager. On success obj holds the object as returned
+// by the API server.
+//
+// Fields changed by other field managers are not overwritten silently. Conflicts are recorded in the Conflicts attached to the context
+// and the apply is then forced, so the operator remains the source of truth. Objects annotated with annotations.RespectUserEdits
+// retain values set by other managers instead: conflicting fields are applied with their live values, so the operator only
+// changes fields nobody else has modified. The annotation can be set either in the manifests or on the live object.
+func Apply(ctx context.Context, cli client.Client, obj *unstructured.Unstructured, opts ...ApplyOption) error {
+	options := &applyOptions{takeOverFrom: map[string]bool{}}
+	for _, opt := range opts {
+		opt(options)
+	}
+	desired := obj.DeepCopy()
+
+	err := applyPatch(ctx, cli, obj, desired)
+	if !k8serr.IsConflict(err) {
+		return err
+	}
+
+	live := &unstructured.Unstructured{}
+	live.SetGroupVersionKind(desired.GroupVersionKind())
+	if err := cli.Get(ctx, client.ObjectKeyFromObject(desired), live); err != nil {
+		return err
+	}
+
+	conflict, takeOver, err := newConflict(desired, live, err, options.takeOverFrom)
+	if err != nil {
+		return err
+	}
+	if len(conflict.Fields) == 0 && takeOver {
+		return applyPatch(ctx, cli, obj, desired, client.ForceOwnership)
+	}
+
+	if respectsUserEdits(desired) || respectsUserEdits(live) {
+		conflict.Resolution = ConflictRetained
+		err = applyRetainingUserEdits(ctx, cli, obj, desired, live, conflict.Fields, takeOver)
+	} else {
+		conflict.Resolution = ConflictForced
+		err = applyPatch(ctx, cli, obj, desired, client.ForceOwnership)
+	}
+
+	ctrlLog.FromContext(ctx).Info("field conflict with other managers", "conflict", conflict.String())
+	if conflicts := conflictsFrom(ctx); conflicts != nil {
+		conflicts.record(conflict)
+	}
+
+	return err
+}
+
+// MergePatch merges the patch into the live object with a JSON merge patch, on behalf of FieldManager. On success patch
+// holds the object as returned by the API server.
+//
+// Patches are partial objects, which can not be sent as server-side apply requests: an apply request of FieldManager
+// would remove the fields the operator applied to the same object and left out of the patch, and lists which are not
+// declared as maps in the schema of the object, such as the extension providers of a ServiceMeshControlPlane, would be
+// owned as a whole. Fields changed by the patch which are managed by other field managers are reported the way Apply
+// reports them, as conflicts resolved by forcing the patched values.
+func MergePatch(ctx context.Context, cli client.Client, live, patch *unstructured.Unstructured, opts ...ApplyOption) error {
+	options := &applyOptions{takeOverFrom: map[string]bool{}}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	conflict, err := newPatchConflict(live, patch, options.takeOverFrom)
+	if err != nil {
+		return err
+	}
+
+	data, err := patch.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	if err := cli.Patch(ctx, patch, client.RawPatch(types.MergePatchType, data), client.FieldOwner(FieldManager)); err != nil {
+		return err
+	}
+
+	if len(conflict.Fields) != 0 {
+		ctrlLog.FromContext(ctx).Info("field conflict with other managers", "conflict", conflict.String())
+		if conflicts := conflictsFrom(ctx); conflicts != nil {
+			conflicts.record(conflict)
+		}
+	}
+
+	return nil
+}
+
+// newPatchConflict describes the fields of the live object changed by the patch which are managed by other managers
+// than the operator and the ones to take over from.
+func newPatchConflict(live, patch *unstructured.Unstructured, takeOverFrom map[string]bool) (Conflict, error) {
+	conflict := Conflict{
+		Object: corev1.ObjectReference{
+			APIVersion: live.GetAPIVersion(),
+			Kind:       live.GetKind(),
+			Namespace:  live.GetNamespace(),
+			Name:       live.GetName(),
+		},
+		Resolution: ConflictForced,
+	}
+
+	managedFields, err := fieldpath.NewManagedFields(live, FieldManager)
+	if err != nil {
+		return conflict, err
+	}
+
+	managers := map[string]struct{}{}
+	for _, field := range changedFields("", live.Object, patch.Object) {
+		conflicting := false
+		for _, manager := range managedFields.ManagersWithin(field) {
+			if manager != FieldManager && !takeOverFrom[manager] {
+				managers[manager] = struct{}{}
+				conflicting = true
+			}
+		}
+		if conflicting {
+			conflict.Fields = append(conflict.Fields, field)
+		}
+	}
+	for manager := range managers {
+		conflict.Managers = append(conflict.Managers, manager)
+	}
+	sort.Strings(conflict.Managers)
+	sort.Strings(conflict.Fields)
+
+	return conflict, nil
+}
+
+// changedFields returns the paths of the existing fields whose value is replaced by the merge patch, formatted the way
+// the API server reports conflicts. Fields added by the patch are left out, as no other manager can manage them.
+func changedFields(path string, live, patch map[string]interface{}) []string {
+	var fields []string
+	for field, patchValue := range patch {
+		liveValue, exists := live[field]
+		if !exists {
+			continue
+		}
+		fieldPath := path + "." + field
+		liveFields, liveIsMap := liveValue.(map[string]interface{})
+		patchFields, patchIsMap := patchValue.(map[string]interface{})
+		switch {
+		case liveIsMap && patchIsMap:
+			fields = append(fields, changedFields(fieldPath, liveFields, patchFields)...)
+		case !sameJSON(liveValue, patchValue):
+			fields = append(fields, fieldPath)
+		}
+	}
+
+	return fields
+}
+
+// sameJSON compares values regardless of the numeric types used by the JSON decoder and unstructured objects.
+func sameJSON(a, b interface{}) bool {
+	aJSON, errA := json.Marshal(a)
+	bJSON, errB := json.Marshal(b)
+
+	return errA == nil && errB == nil && string(aJSON) == string(bJSON)
+}
+
+func respectsUserEdits(obj *unstructured.Unstructured) bool {
+	return obj.GetAnnotations()[annotations.RespectUserEdits] == "true"
+}
+
+func applyPatch(ctx context.Context, cli client.Client, obj, desired *unstructured.Unstructured, opts ...client.PatchOption) error {
+	data, err := json.Marshal(desired)
+	if err != nil {
+		return err
+	}
+
+	opts = append(opts, client.FieldOwner(FieldManager))
+
+	return cli.Patch(ctx, obj, client.RawPatch(types.ApplyPatchType, data), opts...)
+}
+
+// applyRetainingUserEdits applies the desired object with the conflicting fields set to their live values.
+// If the fields cannot be resolved in the object, it is left untouched. Ownership is forced only when fields
+// of managers to take over from are conflicting too.
+func applyRetainingUserEdits(ctx context.Context, cli client.Client, obj, desired, live *unstructured.Unstructured,
+	fields []string, takeOver bool,
+) error {
+	for _, field := range fields {
+		if err := fieldpath.CopyField(desired.Object, live.Object, field); err != nil {
+			ctrlLog.FromContext(ctx).Info("skipping update of object edited by other managers", "object", client.ObjectKeyFromObject(desired), "reason", err.Error())
+			obj.Object = live.Object
+
+			return nil
+		}
+	}
+
+	if takeOver {
+		return applyPatch(ctx, cli, obj, desired, client.ForceOwnership)
+	}
+
+	return applyPatch(ctx, cli, obj, desired)
+}
+
+// newConflict describes the conflict reported by the API server. The causes of the error give the conflicting fields,
+// and the managers of each field are read from the managed fields of the live object. Fields only managed by managers
+// to take over from are left out, the returned flag tells whether there were any.
+func newConflict(obj, live *unstructured.Unstructured, err error, takeOverFrom map[string]bool) (Conflict, bool, error) {
+	conflict := Conflict{
+		Object: corev1.ObjectReference{
+			APIVersion: obj.GetAPIVersion(),
+			Kind:       obj.GetKind(),
+			Namespace:  obj.GetNamespace(),
+			Name:       obj.GetName(),
+		},
+	}
+
+	managedFields, parseErr := fieldpath.NewManagedFields(live, FieldManager)
+	if parseErr != nil {
+		return conflict, false, parseErr
+	}
+
+	var statusErr *k8serr.StatusError
+	takeOver := false
+	fields := map[string]struct{}{}
+	managers := map[string]struct{}{}
+	if errors.As(err, &statusErr) && statusErr.ErrStatus.Details != nil {
+		for _, cause := range statusErr.ErrStatus.Details.Causes {
+			if cause.Type != metav1.CauseTypeFieldManagerConflict {
+				continue
+			}
+			// The API server reports a cause for each manager of a field
+			if _, found := fields[cause.Field]; found {
+				continue
+			}
+			fieldManagers := managedFields.Managers(cause.Field)
+			var others []string
+			for _, manager := range fieldManagers {
+				if !takeOverFrom[manager] {
+					others = append(others, manager)
+				}
+			}
+			if len(others) < len(fieldManagers) {
+				takeOver = true
+				if len(others) == 0 {
+					continue
+				}
+			}
+			fields[cause.Field] = struct{}{}
+			conflict.Fields = append(conflict.Fields, cause.Field)
+			for _, manager := range others {
+				managers[manager] = struct{}{}
+			}
+		}
+	}
+	for manager := range managers {
+		conflict.Managers = append(conflict.Managers, manager)
+	}
+	sort.Strings(conflict.Managers)
+	sort.Strings(conflict.Fields)
+
+	return conflict, takeOver, nil
+}
@@ -44,6 +44,21 @@ func GetOperatorNamespace() (string, error) {
 	return string(data), err
 }
 
+// GetOperatorUsername returns the username the operator authenticates with, given the name of its service account set
+// in the OPERATOR_SERVICE_ACCOUNT variable of its deployment.
+func GetOperatorUsername() (string, error) {
+	namespace, err := GetOperatorNamespace()
+	if err != nil {
+		return "", err
+	}
+	serviceAccount := os.Getenv("OPERATOR_SERVICE_ACCOUNT")
+	if serviceAccount == "" {
+		return "", errors.New("OPERATOR_SERVICE_ACCOUNT is not set")
+	}
+
+	return "system:serviceaccount:" + namespace + ":" + serviceAccount, nil
+}
+
 // GetClusterServiceVersion retries the clusterserviceversions available in the operator namespace.
 func GetClusterServiceVersion(ctx context.Context, c client.Client, watchNameSpace string) (*ofapi.ClusterServiceVersion, error) {
 	clusterServiceVersionList := &ofapi.ClusterServiceVersionList{}
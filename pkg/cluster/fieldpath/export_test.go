@@ -0,0 +1,48 @@
+package fieldpath
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Helpers exposing unexported functions to the tests of the package. Path elements are described the way the API server
+// formats them, with keys sorted.
+
+var QuoteKeys = quoteKeys
+
+func ParseFieldPath(path string) ([]string, error) {
+	elements, err := parseFieldPath(path)
+
+	return describe(elements), err
+}
+
+func Canonicalize(obj map[string]interface{}, path string) ([]string, bool) {
+	elements, err := parseFieldPath(path)
+	if err != nil {
+		return nil, false
+	}
+	elements, found := canonicalize(obj, elements)
+
+	return describe(elements), found
+}
+
+func describe(elements []pathElement) []string {
+	descriptions := make([]string, 0, len(elements))
+	for _, element := range elements {
+		switch {
+		case element.field != "":
+			descriptions = append(descriptions, "."+element.field)
+		case element.hasIndex:
+			descriptions = append(descriptions, fmt.Sprintf("[%d]", element.index))
+		case element.hasValue:
+			value, _ := json.Marshal(element.value)
+			descriptions = append(descriptions, "[="+string(value)+"]")
+		default:
+			keys, _ := json.Marshal(element.keys)
+			descriptions = append(descriptions, "["+strings.Trim(string(keys), "{}")+"]")
+		}
+	}
+
+	return descriptions
+}
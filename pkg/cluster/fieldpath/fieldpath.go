@@ -0,0 +1,295 @@
+// Package fieldpath resolves the field paths reported by the API server in server-side apply conflicts, e.g.
+// .spec.template.spec.containers[name="manager"].image, against unstructured objects.
+package fieldpath
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// pathElement is a single step of a field path, as reported by the API server in server-side apply conflicts,
+// e.g. .spec.template.spec.containers[name="manager"].image.
+type pathElement struct {
+	field string
+	// keys select an item of an associative list, e.g. [name="manager"]
+	keys map[string]interface{}
+	// value selects an item of a set, e.g. [="value"]
+	value    interface{}
+	hasValue bool
+	index    int
+	hasIndex bool
+}
+
+// CopyField sets the field at the given path in dst to its value in src, removing it from dst when it is not set in src.
+func CopyField(dst, src map[string]interface{}, path string) error {
+	elements, err := parseFieldPath(path)
+	if err != nil {
+		return err
+	}
+	elements, found := canonicalize(dst, elements)
+	if !found {
+		return fmt.Errorf("field path %s not found in the applied object", path)
+	}
+	if len(elements) == 0 || elements[len(elements)-1].field == "" {
+		return fmt.Errorf("field path %s does not end with a field", path)
+	}
+
+	parentPath, field := elements[:len(elements)-1], elements[len(elements)-1].field
+	dstParent, _ := resolve(dst, parentPath)
+	dstMap, ok := dstParent.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("field path %s does not point to an object field", path)
+	}
+
+	srcParent, found := resolve(src, parentPath)
+	srcMap, ok := srcParent.(map[string]interface{})
+	if !found || !ok {
+		delete(dstMap, field)
+
+		return nil
+	}
+	if value, exists := srcMap[field]; exists {
+		dstMap[field] = value
+	} else {
+		delete(dstMap, field)
+	}
+
+	return nil
+}
+
+// canonicalize matches the path against the object. Field names containing dots, such as label keys, are split
+// by the path format, so consecutive fields are joined back when the object has such a field.
+func canonicalize(obj interface{}, elements []pathElement) ([]pathElement, bool) {
+	if len(elements) == 0 {
+		return nil, true
+	}
+
+	element := elements[0]
+	if element.field == "" {
+		items, ok := obj.([]interface{})
+		if !ok {
+			return nil, false
+		}
+		item, found := findItem(items, element)
+		if !found {
+			return nil, false
+		}
+		rest, found := canonicalize(item, elements[1:])
+
+		return append([]pathElement{element}, rest...), found
+	}
+
+	fields, ok := obj.(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+	consecutive := 0
+	for consecutive < len(elements) && elements[consecutive].field != "" {
+		consecutive++
+	}
+	// prefer the longest name, as both "app" and "app.kubernetes.io/name" can be keys of the same labels
+	for k := consecutive; k >= 1; k-- {
+		names := make([]string, 0, k)
+		for _, e := range elements[:k] {
+			names = append(names, e.field)
+		}
+		name := strings.Join(names, ".")
+		value, exists := fields[name]
+		if !exists {
+			continue
+		}
+		if rest, found := canonicalize(value, elements[k:]); found {
+			return append([]pathElement{{field: name}}, rest...), true
+		}
+	}
+
+	return nil, false
+}
+
+func resolve(obj interface{}, elements []pathElement) (interface{}, bool) {
+	current := obj
+	for _, element := range elements {
+		switch {
+		case element.field != "":
+			fields, ok := current.(map[string]interface{})
+			if !ok {
+				return nil, false
+			}
+			if current, ok = fields[element.field]; !ok {
+				return nil, false
+			}
+		default:
+			items, ok := current.([]interface{})
+			if !ok {
+				return nil, false
+			}
+			if current, ok = findItem(items, element); !ok {
+				return nil, false
+			}
+		}
+	}
+
+	return current, true
+}
+
+func findItem(items []interface{}, element pathElement) (interface{}, bool) {
+	if element.hasIndex {
+		if element.index < 0 || element.index >= len(items) {
+			return nil, false
+		}
+
+		return items[element.index], true
+	}
+
+	for _, item := range items {
+		if element.hasValue {
+			if sameValue(item, element.value) {
+				return item, true
+			}
+
+			continue
+		}
+
+		fields, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		matches := true
+		for key, value := range element.keys {
+			if !sameValue(fields[key], value) {
+				matches = false
+
+				break
+			}
+		}
+		if matches {
+			return item, true
+		}
+	}
+
+	return nil, false
+}
+
+// sameValue compares values regardless of the numeric types used by the JSON decoder and unstructured objects.
+func sameValue(a, b interface{}) bool {
+	aJSON, errA := json.Marshal(a)
+	bJSON, errB := json.Marshal(b)
+
+	return errA == nil && errB == nil && reflect.DeepEqual(aJSON, bJSON)
+}
+
+func parseFieldPath(path string) ([]pathElement, error) {
+	var elements []pathElement
+	rest := path
+	for rest != "" {
+		switch rest[0] {
+		case '.':
+			end := strings.IndexAny(rest[1:], ".[")
+			if end < 0 {
+				end = len(rest) - 1
+			}
+			elements = append(elements, pathElement{field: rest[1 : end+1]})
+			rest = rest[end+1:]
+		case '[':
+			end := closingBracket(rest)
+			if end < 0 {
+				return nil, fmt.Errorf("invalid field path %s", path)
+			}
+			element, err := parseSelector(rest[1:end])
+			if err != nil {
+				return nil, fmt.Errorf("invalid field path %s: %w", path, err)
+			}
+			elements = append(elements, element)
+			rest = rest[end+1:]
+		default:
+			return nil, fmt.Errorf("invalid field path %s", path)
+		}
+	}
+
+	return elements, nil
+}
+
+// closingBracket returns the index of the bracket closing the selector at the beginning of s, skipping quoted values.
+func closingBracket(s string) int {
+	inString := false
+	for i := 1; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && inString:
+			i++
+		case s[i] == '"':
+			inString = !inString
+		case s[i] == ']' && !inString:
+			return i
+		}
+	}
+
+	return -1
+}
+
+func parseSelector(selector string) (pathElement, error) {
+	if index, err := strconv.Atoi(selector); err == nil {
+		return pathElement{index: index, hasIndex: true}, nil
+	}
+
+	if strings.HasPrefix(selector, "=") {
+		var value interface{}
+		if err := json.Unmarshal([]byte(selector[1:]), &value); err != nil {
+			return pathElement{}, err
+		}
+
+		return pathElement{value: value, hasValue: true}, nil
+	}
+
+	// Keys are formatted as key=value pairs with JSON values, separated by commas
+	keys := map[string]interface{}{}
+	decoder := json.NewDecoder(strings.NewReader("{" + quoteKeys(selector) + "}"))
+	if err := decoder.Decode(&keys); err != nil {
+		return pathElement{}, err
+	}
+
+	return pathElement{keys: keys}, nil
+}
+
+// quoteKeys converts key=value pairs into JSON object members, e.g. name="manager",port=8080 into "name":"manager","port":8080.
+func quoteKeys(selector string) string {
+	var builder strings.Builder
+	inString := false
+	atKey := true
+	for i := 0; i < len(selector); i++ {
+		c := selector[i]
+		switch {
+		case inString:
+			builder.WriteByte(c)
+			if c == '\\' && i+1 < len(selector) {
+				i++
+				builder.WriteByte(selector[i])
+			} else if c == '"' {
+				inString = false
+			}
+		case atKey:
+			end := strings.IndexByte(selector[i:], '=')
+			if end < 0 {
+				builder.WriteString(selector[i:])
+
+				return builder.String()
+			}
+			builder.WriteString(strconv.Quote(selector[i : i+end]))
+			builder.WriteByte(':')
+			i += end
+			atKey = false
+		case c == '"':
+			inString = true
+			builder.WriteByte(c)
+		case c == ',':
+			builder.WriteByte(c)
+			atKey = true
+		default:
+			builder.WriteByte(c)
+		}
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,13 @@
+package fieldpath_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestFieldPath(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Field path unit tests")
+}
@@ -0,0 +1,148 @@
+package fieldpath_test
+
+import (
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/fieldpath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Field paths reported in conflicts", func() {
+
+	Context("parsing", func() {
+
+		DescribeTable("should split the path into fields and selectors",
+			func(path string, expected []string) {
+				Expect(fieldpath.ParseFieldPath(path)).To(Equal(expected))
+			},
+			Entry("fields", ".spec.replicas", []string{".spec", ".replicas"}),
+			Entry("associative list item", `.spec.containers[name="manager"].image`,
+				[]string{".spec", ".containers", `["name":"manager"]`, ".image"}),
+			Entry("associative list item with several keys", `.spec.ports[port=8080,protocol="TCP"].name`,
+				[]string{".spec", ".ports", `["port":8080,"protocol":"TCP"]`, ".name"}),
+			Entry("set item", `.metadata.finalizers[="example.com/finalizer"]`,
+				[]string{".metadata", ".finalizers", `[="example.com/finalizer"]`}),
+			Entry("list index", ".spec.args[1]", []string{".spec", ".args", "[1]"}),
+			Entry("quoted brackets", `.spec.env[name="a]b"].value`, []string{".spec", ".env", `["name":"a]b"]`, ".value"}),
+			Entry("dotted key split as fields", ".metadata.labels.app.kubernetes.io/name",
+				[]string{".metadata", ".labels", ".app", ".kubernetes", ".io/name"}),
+		)
+
+		DescribeTable("should reject invalid paths",
+			func(path string) {
+				_, err := fieldpath.ParseFieldPath(path)
+				Expect(err).To(HaveOccurred())
+			},
+			Entry("missing leading dot", "spec.replicas"),
+			Entry("unclosed selector", `.spec.containers[name="manager"`),
+			Entry("invalid selector value", ".spec.containers[name=manager]"),
+		)
+	})
+
+	DescribeTable("should quote the keys of selectors",
+		func(selector, expected string) {
+			Expect(fieldpath.QuoteKeys(selector)).To(Equal(expected))
+		},
+		Entry("single key", `name="manager"`, `"name":"manager"`),
+		Entry("several keys", `port=8080,protocol="TCP"`, `"port":8080,"protocol":"TCP"`),
+		Entry("separators in values", `name="a,b=c"`, `"name":"a,b=c"`),
+		Entry("escaped quotes in values", `name="a\",b"`, `"name":"a\",b"`),
+	)
+
+	Context("canonicalizing against an object", func() {
+
+		obj := map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{
+					"app":                    "dashboard",
+					"app.kubernetes.io/name": "dashboard",
+				},
+			},
+			"spec": map[string]interface{}{
+				"containers": []interface{}{
+					map[string]interface{}{"name": "manager", "image": "quay.io/odh/manager"},
+				},
+				"ports": []interface{}{
+					map[string]interface{}{"port": int64(8080), "protocol": "TCP"},
+				},
+			},
+		}
+
+		DescribeTable("should match the path against the fields and items of the object",
+			func(path string, expected []string) {
+				elements, found := fieldpath.Canonicalize(obj, path)
+				Expect(found).To(BeTrue())
+				Expect(elements).To(Equal(expected))
+			},
+			Entry("fields split on the dots of a key", ".metadata.labels.app.kubernetes.io/name",
+				[]string{".metadata", ".labels", ".app.kubernetes.io/name"}),
+			Entry("key without dots", ".metadata.labels.app", []string{".metadata", ".labels", ".app"}),
+			Entry("list item matched regardless of numeric types", `.spec.ports[port=8080,protocol="TCP"].protocol`,
+				[]string{".spec", ".ports", `["port":8080,"protocol":"TCP"]`, ".protocol"}),
+		)
+
+		It("should not find a missing list item", func() {
+			_, found := fieldpath.Canonicalize(obj, `.spec.containers[name="sidecar"].image`)
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Context("copying fields", func() {
+
+		var dst, src map[string]interface{}
+
+		BeforeEach(func() {
+			dst = map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"labels": map[string]interface{}{"app.kubernetes.io/name": "desired"},
+				},
+				"spec": map[string]interface{}{
+					"replicas": int64(1),
+					"containers": []interface{}{
+						map[string]interface{}{"name": "manager", "image": "desired", "args": []interface{}{"--desired"}},
+					},
+				},
+			}
+			src = map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"labels": map[string]interface{}{"app.kubernetes.io/name": "live"},
+				},
+				"spec": map[string]interface{}{
+					"replicas": int64(3),
+					"containers": []interface{}{
+						map[string]interface{}{"name": "manager", "image": "live"},
+					},
+				},
+			}
+		})
+
+		It("should set a field to its value in the source", func() {
+			Expect(fieldpath.CopyField(dst, src, ".spec.replicas")).To(Succeed())
+			Expect(dst["spec"]).To(HaveKeyWithValue("replicas", int64(3)))
+		})
+
+		It("should set a field of a list item", func() {
+			Expect(fieldpath.CopyField(dst, src, `.spec.containers[name="manager"].image`)).To(Succeed())
+			Expect(dst["spec"]).To(HaveKeyWithValue("containers", ContainElement(HaveKeyWithValue("image", "live"))))
+		})
+
+		It("should set a field whose key contains dots", func() {
+			Expect(fieldpath.CopyField(dst, src, ".metadata.labels.app.kubernetes.io/name")).To(Succeed())
+			Expect(dst["metadata"]).To(HaveKeyWithValue("labels", HaveKeyWithValue("app.kubernetes.io/name", "live")))
+		})
+
+		It("should remove a field which is not set in the source", func() {
+			Expect(fieldpath.CopyField(dst, src, `.spec.containers[name="manager"].args`)).To(Succeed())
+			Expect(dst["spec"]).To(HaveKeyWithValue("containers", ContainElement(Not(HaveKey("args")))))
+		})
+
+		It("should fail when the field is not in the destination", func() {
+			Expect(fieldpath.CopyField(dst, src, ".spec.paused")).To(MatchError(ContainSubstring("not found in the applied object")))
+		})
+
+		It("should fail when the path does not end with a field", func() {
+			Expect(fieldpath.CopyField(dst, src, `.spec.containers[name="manager"]`)).
+				To(MatchError(ContainSubstring("does not end with a field")))
+		})
+	})
+})
@@ -0,0 +1,72 @@
+package fieldpath
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	smdfieldpath "sigs.k8s.io/structured-merge-diff/v4/fieldpath"
+)
+
+// ManagedFields holds the fields of an object managed by each field manager, as recorded in its managed fields.
+type ManagedFields map[string]*smdfieldpath.Set
+
+// NewManagedFields reads the fields of the object managed by all managers but the given applier, whose applied
+// fields never conflict with its own apply requests. Fields of subresources, such as the status, are left out.
+func NewManagedFields(obj metav1.Object, applier string) (ManagedFields, error) {
+	fields := ManagedFields{}
+	for _, entry := range obj.GetManagedFields() {
+		if entry.Subresource != "" || entry.FieldsV1 == nil ||
+			(entry.Manager == applier && entry.Operation == metav1.ManagedFieldsOperationApply) {
+			continue
+		}
+		set := &smdfieldpath.Set{}
+		if err := set.FromJSON(bytes.NewReader(entry.FieldsV1.Raw)); err != nil {
+			return nil, fmt.Errorf("failed reading fields managed by %s: %w", entry.Manager, err)
+		}
+		if managed, found := fields[entry.Manager]; found {
+			set = managed.Union(set)
+		}
+		fields[entry.Manager] = set
+	}
+
+	return fields, nil
+}
+
+// Managers returns the managers of the field, whose path is formatted the way the API server reports conflicts.
+func (m ManagedFields) Managers(path string) []string {
+	var managers []string
+	for manager, set := range m {
+		managed := false
+		set.Iterate(func(p smdfieldpath.Path) {
+			managed = managed || p.String() == path
+		})
+		if managed {
+			managers = append(managers, manager)
+		}
+	}
+	sort.Strings(managers)
+
+	return managers
+}
+
+// ManagersWithin returns the managers of the field or of any field it holds, e.g. of the items of a list, whose path is
+// formatted the way the API server reports conflicts.
+func (m ManagedFields) ManagersWithin(path string) []string {
+	var managers []string
+	for manager, set := range m {
+		managed := false
+		set.Iterate(func(p smdfieldpath.Path) {
+			field := p.String()
+			managed = managed || field == path || strings.HasPrefix(field, path+".") || strings.HasPrefix(field, path+"[")
+		})
+		if managed {
+			managers = append(managers, manager)
+		}
+	}
+	sort.Strings(managers)
+
+	return managers
+}
@@ -0,0 +1,94 @@
+package fieldpath_test
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/fieldpath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Managers of fields", func() {
+
+	entry := func(manager string, operation metav1.ManagedFieldsOperationType, subresource, fields string) metav1.ManagedFieldsEntry {
+		return metav1.ManagedFieldsEntry{
+			Manager:     manager,
+			Operation:   operation,
+			Subresource: subresource,
+			FieldsType:  "FieldsV1",
+			FieldsV1:    &metav1.FieldsV1{Raw: []byte(fields)},
+		}
+	}
+
+	managedBy := func(entries ...metav1.ManagedFieldsEntry) fieldpath.ManagedFields {
+		obj := &metav1.ObjectMeta{ManagedFields: entries}
+		managedFields, err := fieldpath.NewManagedFields(obj, "opendatahub-operator")
+		Expect(err).ToNot(HaveOccurred())
+
+		return managedFields
+	}
+
+	It("should return the managers of a field, as formatted in conflicts", func() {
+		managedFields := managedBy(
+			entry("kubectl-edit", metav1.ManagedFieldsOperationUpdate, "", `{"f:spec":{"f:replicas":{}}}`),
+			entry("kubectl-client-side-apply", metav1.ManagedFieldsOperationUpdate, "",
+				`{"f:spec":{"f:replicas":{},"f:template":{"f:spec":{"f:containers":{"k:{\"name\":\"manager\"}":{"f:image":{}}}}}}}`),
+		)
+
+		Expect(managedFields.Managers(".spec.replicas")).To(Equal([]string{"kubectl-client-side-apply", "kubectl-edit"}))
+		Expect(managedFields.Managers(`.spec.template.spec.containers[name="manager"].image`)).
+			To(Equal([]string{"kubectl-client-side-apply"}))
+		Expect(managedFields.Managers(".spec.paused")).To(BeEmpty())
+	})
+
+	It("should return the managers of the fields held by a field", func() {
+		managedFields := managedBy(
+			entry("kubectl-edit", metav1.ManagedFieldsOperationUpdate, "", `{"f:spec":{"f:replicas":{}}}`),
+			entry("kubectl-client-side-apply", metav1.ManagedFieldsOperationUpdate, "",
+				`{"f:spec":{"f:template":{"f:spec":{"f:containers":{"k:{\"name\":\"manager\"}":{"f:image":{}}}}}}}`),
+		)
+
+		Expect(managedFields.ManagersWithin(".spec.template.spec.containers")).To(Equal([]string{"kubectl-client-side-apply"}))
+		Expect(managedFields.ManagersWithin(".spec")).To(Equal([]string{"kubectl-client-side-apply", "kubectl-edit"}))
+		Expect(managedFields.ManagersWithin(".spec.replicas")).To(Equal([]string{"kubectl-edit"}))
+		Expect(managedFields.ManagersWithin(".spec.templates")).To(BeEmpty())
+	})
+
+	It("should leave out fields applied by the given applier and fields of subresources", func() {
+		managedFields := managedBy(
+			entry("opendatahub-operator", metav1.ManagedFieldsOperationApply, "", `{"f:spec":{"f:replicas":{}}}`),
+			entry("kube-controller-manager", metav1.ManagedFieldsOperationUpdate, "status", `{"f:status":{"f:replicas":{}}}`),
+		)
+
+		Expect(managedFields.Managers(".spec.replicas")).To(BeEmpty())
+		Expect(managedFields.Managers(".status.replicas")).To(BeEmpty())
+	})
+
+	It("should keep fields updated by the applier without applying them", func() {
+		managedFields := managedBy(
+			entry("opendatahub-operator", metav1.ManagedFieldsOperationUpdate, "", `{"f:spec":{"f:replicas":{}}}`),
+		)
+
+		Expect(managedFields.Managers(".spec.replicas")).To(Equal([]string{"opendatahub-operator"}))
+	})
+
+	It("should merge the fields of a manager recorded in several entries", func() {
+		managedFields := managedBy(
+			entry("kubectl-edit", metav1.ManagedFieldsOperationUpdate, "", `{"f:spec":{"f:replicas":{}}}`),
+			entry("kubectl-edit", metav1.ManagedFieldsOperationApply, "", `{"f:spec":{"f:paused":{}}}`),
+		)
+
+		Expect(managedFields.Managers(".spec.replicas")).To(Equal([]string{"kubectl-edit"}))
+		Expect(managedFields.Managers(".spec.paused")).To(Equal([]string{"kubectl-edit"}))
+	})
+
+	It("should fail on invalid managed fields", func() {
+		obj := &metav1.ObjectMeta{ManagedFields: []metav1.ManagedFieldsEntry{
+			entry("kubectl-edit", metav1.ManagedFieldsOperationUpdate, "", `{"f:spec":`),
+		}}
+
+		_, err := fieldpath.NewManagedFields(obj, "opendatahub-operator")
+		Expect(err).To(MatchError(ContainSubstring("kubectl-edit")))
+	})
+})
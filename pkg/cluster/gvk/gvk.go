@@ -3,6 +3,12 @@ package gvk
 import "k8s.io/apimachinery/pkg/runtime/schema"
 
 var (
+	DataScienceCluster = schema.GroupVersionKind{
+		Group:   "datasciencecluster.opendatahub.io",
+		Version: "v1",
+		Kind:    "DataScienceCluster",
+	}
+
 	ClusterServiceVersion = schema.GroupVersionKind{
 		Group:   "operators.coreos.com",
 		Version: "v1alpha1",
@@ -21,6 +27,12 @@ var (
 		Kind:    "Ingress",
 	}
 
+	OpenshiftImageConfig = schema.GroupVersionKind{
+		Group:   "config.openshift.io",
+		Version: "v1",
+		Kind:    "Image",
+	}
+
 	ServiceMeshControlPlane = schema.GroupVersionKind{
 		Group:   "maistra.io",
 		Version: "v2",
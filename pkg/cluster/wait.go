@@ -0,0 +1,117 @@
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
+)
+
+// DefaultRequeueAfter is the delay after which a reconciliation waiting for the cluster is resumed.
+const DefaultRequeueAfter = 10 * time.Second
+
+// NotReadyError is returned by steps waiting for the cluster to reach some state, e.g. pods to be ready, instead of
+// blocking the reconciliation in a polling loop. Controllers requeue the reconciliation after RequeueAfter, the step
+// being checked again then, and report its message as the progress of the reconciliation.
+type NotReadyError struct {
+	Message      string
+	RequeueAfter time.Duration
+}
+
+// NewNotReadyError returns a NotReadyError with the given message, resumed after DefaultRequeueAfter.
+func NewNotReadyError(format string, args ...any) *NotReadyError {
+	return &NotReadyError{
+		Message:      fmt.Sprintf(format, args...),
+		RequeueAfter: DefaultRequeueAfter,
+	}
+}
+
+func (e *NotReadyError) Error() string {
+	return e.Message
+}
+
+// AsNotReady tells whether err only reports steps waiting for the cluster, in which case the NotReadyError resumed
+// the soonest is returned. Errors aggregating other failures are not, as they have to be reported as such.
+func AsNotReady(err error) (*NotReadyError, bool) {
+	if err == nil {
+		return nil, false
+	}
+
+	var wrapped []error
+	switch e := err.(type) { //nolint:errorlint // Reason: aggregated errors are walked one level at a time
+	case *NotReadyError:
+		return e, true
+	case interface{ WrappedErrors() []error }:
+		wrapped = e.WrappedErrors()
+	case interface{ Unwrap() []error }:
+		wrapped = e.Unwrap()
+	default:
+		if unwrapped := errors.Unwrap(err); unwrapped != nil {
+			return AsNotReady(unwrapped)
+		}
+
+		return nil, false
+	}
+
+	var soonest *NotReadyError
+	for _, wrappedErr := range wrapped {
+		if wrappedErr == nil {
+			continue
+		}
+		notReady, ok := AsNotReady(wrappedErr)
+		if !ok {
+			return nil, false
+		}
+		if soonest == nil || notReady.RequeueAfter < soonest.RequeueAfter {
+			soonest = notReady
+		}
+	}
+
+	return soonest, soonest != nil
+}
+
+// IsNotReady tells whether err only reports steps waiting for the cluster.
+func IsNotReady(err error) bool {
+	_, ok := AsNotReady(err)
+
+	return ok
+}
+
+// RequeueIfNotReady translates err into the result of a reconciliation: steps waiting for the cluster are resumed
+// later, while other errors are returned as is.
+func RequeueIfNotReady(err error) (reconcile.Result, error) {
+	if notReady, ok := AsNotReady(err); ok {
+		return reconcile.Result{RequeueAfter: notReady.RequeueAfter}, nil
+	}
+
+	return reconcile.Result{}, err
+}
+
+// CheckDeploymentAvailable checks whether the deployments of the component in namespace are ready, e.g. before
+// applying the prometheus rules of the component. It returns a NotReadyError while they are not.
+func CheckDeploymentAvailable(ctx context.Context, c client.Client, componentName string, namespace string) error {
+	componentDeploymentList := &appsv1.DeploymentList{}
+	err := c.List(ctx, componentDeploymentList, client.InNamespace(namespace), client.HasLabels{labels.ODH.Component(componentName)})
+	if err != nil {
+		return fmt.Errorf("error fetching list of deployments: %w", err)
+	}
+
+	readyDeployments := 0
+	for _, deployment := range componentDeploymentList.Items {
+		if deployment.Status.ReadyReplicas == deployment.Status.Replicas {
+			readyDeployments++
+		}
+	}
+	if readyDeployments != len(componentDeploymentList.Items) {
+		return NewNotReadyError("%d/%d deployments of %s are ready in namespace %s",
+			readyDeployments, len(componentDeploymentList.Items), componentName, namespace)
+	}
+
+	return nil
+}
@@ -0,0 +1,245 @@
+package deploy
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/components"
+)
+
+// DefaultManifestsCacheMaxAge is how long archives which are not used anymore are kept in a ManifestsCache.
+const DefaultManifestsCacheMaxAge = 24 * time.Hour
+
+var manifestsClient = &http.Client{Timeout: 5 * time.Minute}
+
+// ManifestsCache is the directory downloaded manifests archives are cached in. Archives are stored under their
+// SHA-256 digest, and the digest last downloaded from each URI is recorded along with its ETag.
+// Archives which have not been used for maxAge are evicted, as well as the references to them.
+type ManifestsCache struct {
+	dir    string
+	maxAge time.Duration
+
+	// evictMu prevents concurrent evictions
+	evictMu sync.Mutex
+}
+
+// NewManifestsCache returns a cache of the archives stored in dir, evicting them once they have not been used for maxAge.
+func NewManifestsCache(dir string, maxAge time.Duration) *ManifestsCache {
+	return &ManifestsCache{dir: dir, maxAge: maxAge}
+}
+
+type manifestsCacheKey struct{}
+
+// WithManifestsCache returns a context in which downloaded manifests archives are kept in the given cache.
+// Without cache, archives are only kept while being extracted.
+func WithManifestsCache(ctx context.Context, cache *ManifestsCache) context.Context {
+	return context.WithValue(ctx, manifestsCacheKey{}, cache)
+}
+
+// manifestsCacheFrom returns the cache attached to the context, or a temporary one which has to be removed with the
+// returned function once archives are extracted. The cache is not used in plan mode, so that computing a plan does not
+// change the archives deployed by the next reconcile.
+func manifestsCacheFrom(ctx context.Context) (*ManifestsCache, func(), error) {
+	if cache, _ := ctx.Value(manifestsCacheKey{}).(*ManifestsCache); cache != nil && PlanFrom(ctx) == nil {
+		return cache, func() {}, nil
+	}
+
+	dir, err := os.MkdirTemp("", "odh-manifests-download-")
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating manifests download directory: %w", err)
+	}
+
+	return &ManifestsCache{dir: dir}, func() { _ = os.RemoveAll(dir) }, nil
+}
+
+// cachedReference is the archive last downloaded from a URI.
+type cachedReference struct {
+	Digest string `json:"digest"`
+	ETag   string `json:"etag,omitempty"`
+}
+
+// fetchArchive returns the path of the cached archive for the given manifests config, downloading it when the cache is not warm.
+// Archives with an expected digest are downloaded only once, the other ones are revalidated using the ETag of the previous download.
+func (c *ManifestsCache) fetchArchive(ctx context.Context, manifestConfig components.ManifestsConfig) (string, error) {
+	expected := strings.ToLower(manifestConfig.SHA256)
+	if expected != "" && c.use(expected) {
+		return c.archivePath(expected), nil
+	}
+
+	// Get the component repo from the given url
+	// e.g.  https://github.com/example/tarball/master
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, manifestConfig.URI, nil)
+	if err != nil {
+		return "", err
+	}
+	ref, refErr := c.readReference(manifestConfig.URI)
+	if expected == "" && refErr == nil && ref.ETag != "" && c.use(ref.Digest) {
+		req.Header.Set("If-None-Match", ref.ETag)
+	}
+
+	resp, err := manifestsClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error downloading manifests: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified {
+		return c.archivePath(ref.Digest), nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("error downloading manifests: %v HTTP status", resp.StatusCode)
+	}
+
+	digest, err := c.storeArchive(resp.Body, expected)
+	if err != nil {
+		return "", fmt.Errorf("error downloading manifests from %s: %w", manifestConfig.URI, err)
+	}
+	if err := c.writeReference(manifestConfig.URI, cachedReference{Digest: digest, ETag: resp.Header.Get("ETag")}); err != nil {
+		return "", err
+	}
+
+	return c.archivePath(digest), nil
+}
+
+// use tells whether the archive is cached, and marks it as used so that it is not evicted.
+func (c *ManifestsCache) use(digest string) bool {
+	now := time.Now()
+
+	return os.Chtimes(c.archivePath(digest), now, now) == nil
+}
+
+// storeArchive writes the archive to the cache and returns its digest. The archive is discarded when it does not match
+// the expected digest. Archives and references unused for maxAge are evicted once the archive is stored.
+func (c *ManifestsCache) storeArchive(body io.Reader, expected string) (string, error) {
+	if err := os.MkdirAll(filepath.Join(c.dir, "sha256"), os.ModePerm); err != nil {
+		return "", fmt.Errorf("error creating manifests cache directory: %w", err)
+	}
+	file, err := os.CreateTemp(filepath.Join(c.dir, "sha256"), "download-*")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(file.Name())
+
+	hash := sha256.New()
+	_, err = io.Copy(io.MultiWriter(file, hash), body)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", err
+	}
+
+	digest := hex.EncodeToString(hash.Sum(nil))
+	if expected != "" && digest != expected {
+		return "", fmt.Errorf("checksum mismatch: expected sha256 %s, got %s", expected, digest)
+	}
+
+	// Renaming is atomic, so concurrent downloads of the same archive never expose partial content
+	if err := os.Rename(file.Name(), c.archivePath(digest)); err != nil {
+		return "", err
+	}
+
+	return digest, c.evict()
+}
+
+// evict removes archives which have not been used for maxAge, and references to archives which are not cached anymore.
+func (c *ManifestsCache) evict() error {
+	if c.maxAge <= 0 {
+		return nil
+	}
+	c.evictMu.Lock()
+	defer c.evictMu.Unlock()
+
+	archives, err := os.ReadDir(filepath.Join(c.dir, "sha256"))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("error evicting manifests archives: %w", err)
+	}
+	for _, entry := range archives {
+		info, err := entry.Info()
+		if err != nil || time.Since(info.ModTime()) < c.maxAge {
+			continue
+		}
+		if err := os.Remove(filepath.Join(c.dir, "sha256", entry.Name())); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("error evicting manifests archives: %w", err)
+		}
+	}
+
+	refs, err := os.ReadDir(filepath.Join(c.dir, "refs"))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("error evicting manifests archives: %w", err)
+	}
+	for _, entry := range refs {
+		path := filepath.Join(c.dir, "refs", entry.Name())
+		ref := cachedReference{}
+		if content, err := os.ReadFile(path); err == nil && json.Unmarshal(content, &ref) == nil && ref.Digest != "" && fileExists(c.archivePath(ref.Digest)) {
+			continue
+		}
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("error evicting manifests archives: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (c *ManifestsCache) archivePath(digest string) string {
+	return filepath.Join(c.dir, "sha256", digest+".tar.gz")
+}
+
+func (c *ManifestsCache) referencePath(uri string) string {
+	key := sha256.Sum256([]byte(uri))
+
+	return filepath.Join(c.dir, "refs", hex.EncodeToString(key[:])+".json")
+}
+
+func (c *ManifestsCache) readReference(uri string) (cachedReference, error) {
+	ref := cachedReference{}
+	content, err := os.ReadFile(c.referencePath(uri))
+	if err != nil {
+		return ref, err
+	}
+
+	return ref, json.Unmarshal(content, &ref)
+}
+
+func (c *ManifestsCache) writeReference(uri string, ref cachedReference) error {
+	content, err := json.Marshal(ref)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Join(c.dir, "refs"), os.ModePerm); err != nil {
+		return fmt.Errorf("error creating manifests cache directory: %w", err)
+	}
+	file, err := os.CreateTemp(filepath.Join(c.dir, "refs"), "ref-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	_, err = file.Write(content)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), c.referencePath(uri))
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+
+	return err == nil
+}
@@ -0,0 +1,150 @@
+package deploy_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/components"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Caching manifests archives", func() {
+
+	var (
+		ctx      context.Context
+		cacheDir string
+		cache    *deploy.ManifestsCache
+		server   *httptest.Server
+		archive  []byte
+		etag     string
+		requests atomic.Int32
+		notMod   atomic.Int32
+	)
+
+	digestOf := func(content []byte) string {
+		sum := sha256.Sum256(content)
+
+		return hex.EncodeToString(sum[:])
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		cacheDir = GinkgoT().TempDir()
+		cache = deploy.NewManifestsCache(cacheDir, time.Hour)
+		archive = archiveOf(archiveEntry{name: "repo/manifests/kustomization.yaml", content: []byte("resources: []")})
+		etag = `"v1"`
+		requests.Store(0)
+		notMod.Store(0)
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			if r.Header.Get("If-None-Match") == etag {
+				notMod.Add(1)
+				w.WriteHeader(http.StatusNotModified)
+
+				return
+			}
+			w.Header().Set("ETag", etag)
+			_, _ = w.Write(archive)
+		}))
+		DeferCleanup(server.Close)
+	})
+
+	It("should store the archive under its digest", func() {
+		path, err := deploy.FetchArchive(ctx, cache, components.ManifestsConfig{URI: server.URL})
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(path).To(Equal(filepath.Join(cacheDir, "sha256", digestOf(archive)+".tar.gz")))
+		Expect(os.ReadFile(path)).To(Equal(archive))
+	})
+
+	It("should reject an archive which does not match the expected checksum", func() {
+		expected := digestOf([]byte("other content"))
+
+		_, err := deploy.FetchArchive(ctx, cache, components.ManifestsConfig{URI: server.URL, SHA256: expected})
+
+		Expect(err).To(MatchError(ContainSubstring("checksum mismatch")))
+		Expect(filepath.Join(cacheDir, "sha256", expected+".tar.gz")).ToNot(BeAnExistingFile())
+		Expect(filepath.Join(cacheDir, "sha256", digestOf(archive)+".tar.gz")).ToNot(BeAnExistingFile())
+	})
+
+	It("should download an archive with an expected checksum only once", func() {
+		config := components.ManifestsConfig{URI: server.URL, SHA256: digestOf(archive)}
+
+		_, err := deploy.FetchArchive(ctx, cache, config)
+		Expect(err).ToNot(HaveOccurred())
+		path, err := deploy.FetchArchive(ctx, cache, config)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(requests.Load()).To(BeEquivalentTo(1))
+		Expect(os.ReadFile(path)).To(Equal(archive))
+	})
+
+	It("should revalidate an archive without checksum using its ETag", func() {
+		config := components.ManifestsConfig{URI: server.URL}
+
+		first, err := deploy.FetchArchive(ctx, cache, config)
+		Expect(err).ToNot(HaveOccurred())
+		second, err := deploy.FetchArchive(ctx, cache, config)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(requests.Load()).To(BeEquivalentTo(2))
+		Expect(notMod.Load()).To(BeEquivalentTo(1))
+		Expect(second).To(Equal(first))
+	})
+
+	It("should download the archive again once its ETag changes", func() {
+		config := components.ManifestsConfig{URI: server.URL}
+		first, err := deploy.FetchArchive(ctx, cache, config)
+		Expect(err).ToNot(HaveOccurred())
+
+		archive = archiveOf(archiveEntry{name: "repo/manifests/kustomization.yaml", content: []byte("resources: [deployment.yaml]")})
+		etag = `"v2"`
+		second, err := deploy.FetchArchive(ctx, cache, config)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(notMod.Load()).To(BeZero())
+		Expect(second).ToNot(Equal(first))
+		Expect(os.ReadFile(second)).To(Equal(archive))
+	})
+
+	It("should evict archives which have not been used for the maximum age, and the references to them", func() {
+		path, err := deploy.FetchArchive(ctx, cache, components.ManifestsConfig{URI: server.URL})
+		Expect(err).ToNot(HaveOccurred())
+		refs, err := os.ReadDir(filepath.Join(cacheDir, "refs"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(refs).To(HaveLen(1))
+
+		old := time.Now().Add(-2 * time.Hour)
+		Expect(os.Chtimes(path, old, old)).To(Succeed())
+		Expect(deploy.EvictManifestsCache(cache)).To(Succeed())
+
+		Expect(path).ToNot(BeAnExistingFile())
+		Expect(filepath.Join(cacheDir, "refs")).To(BeADirectory())
+		Expect(os.ReadDir(filepath.Join(cacheDir, "refs"))).To(BeEmpty())
+	})
+
+	It("should keep archives which have been used recently", func() {
+		config := components.ManifestsConfig{URI: server.URL, SHA256: digestOf(archive)}
+		path, err := deploy.FetchArchive(ctx, cache, config)
+		Expect(err).ToNot(HaveOccurred())
+
+		old := time.Now().Add(-2 * time.Hour)
+		Expect(os.Chtimes(path, old, old)).To(Succeed())
+		_, err = deploy.FetchArchive(ctx, cache, config)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deploy.EvictManifestsCache(cache)).To(Succeed())
+
+		Expect(path).To(BeARegularFile())
+	})
+})
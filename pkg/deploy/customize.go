@@ -0,0 +1,108 @@
+package deploy
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/components"
+)
+
+// defaultContainerAnnotation names the main container of pods, e.g. the one kubectl logs reads from by default.
+const defaultContainerAnnotation = "kubectl.kubernetes.io/default-container"
+
+type componentConfigKey struct{}
+
+// WithComponentConfig returns a context which makes DeployManifestsFromPath apply the workload configuration of the
+// reconciled component to the Deployments it deploys. Fields set by the configuration take precedence over preserved fields.
+func WithComponentConfig(ctx context.Context, config *components.Config) context.Context {
+	return context.WithValue(ctx, componentConfigKey{}, config)
+}
+
+func componentConfigFrom(ctx context.Context) *components.Config {
+	config, _ := ctx.Value(componentConfigKey{}).(*components.Config)
+
+	return config
+}
+
+// customizeWorkload sets the fields of a Deployment configured for the component.
+func customizeWorkload(obj *unstructured.Unstructured, config *components.Config) error {
+	if config.IsEmpty() || obj.GroupVersionKind().Group != "apps" || obj.GetKind() != "Deployment" {
+		return nil
+	}
+
+	if config.Replicas != nil {
+		if err := unstructured.SetNestedField(obj.Object, int64(*config.Replicas), "spec", "replicas"); err != nil {
+			return err
+		}
+	}
+
+	podSpecPath := []string{"spec", "template", "spec"}
+	if placement := config.NodePlacement; placement != nil {
+		if placement.NodeSelector != nil {
+			if err := unstructured.SetNestedStringMap(obj.Object, placement.NodeSelector, append(podSpecPath, "nodeSelector")...); err != nil {
+				return err
+			}
+		}
+		if placement.Tolerations != nil {
+			tolerations := make([]interface{}, 0, len(placement.Tolerations))
+			for i := range placement.Tolerations {
+				toleration, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&placement.Tolerations[i])
+				if err != nil {
+					return err
+				}
+				tolerations = append(tolerations, toleration)
+			}
+			if err := unstructured.SetNestedSlice(obj.Object, tolerations, append(podSpecPath, "tolerations")...); err != nil {
+				return err
+			}
+		}
+	}
+
+	var resources map[string]interface{}
+	if config.Resources != nil {
+		var err error
+		if resources, err = runtime.DefaultUnstructuredConverter.ToUnstructured(config.Resources); err != nil {
+			return err
+		}
+	}
+	images := map[string]string{}
+	for _, override := range config.ImageOverrides {
+		images[override.Container] = override.Image
+	}
+	containers, found, err := unstructured.NestedSlice(obj.Object, append(podSpecPath, "containers")...)
+	if err != nil || !found {
+		return err
+	}
+	primary := primaryContainer(obj, containers)
+	for i, c := range containers {
+		container, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		// resources are sized for the workload of the component, not for its sidecars
+		if resources != nil && i == primary {
+			container["resources"] = runtime.DeepCopyJSONValue(resources)
+		}
+		name, _ := container["name"].(string)
+		if image, found := images[name]; found {
+			container["image"] = image
+		}
+	}
+
+	return unstructured.SetNestedSlice(obj.Object, containers, append(podSpecPath, "containers")...)
+}
+
+// primaryContainer returns the index of the main container of the Deployment, which is the one named by the
+// kubectl.kubernetes.io/default-container annotation of its pods, or the first one.
+func primaryContainer(obj *unstructured.Unstructured, containers []interface{}) int {
+	defaultContainer, _, _ := unstructured.NestedString(obj.Object, "spec", "template", "metadata", "annotations", defaultContainerAnnotation)
+	for i, c := range containers {
+		if container, ok := c.(map[string]interface{}); ok && defaultContainer != "" && container["name"] == defaultContainer {
+			return i
+		}
+	}
+
+	return 0
+}
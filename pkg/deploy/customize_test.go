@@ -0,0 +1,73 @@
+package deploy_test
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/components"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Customizing workloads of components", func() {
+
+	sidecarResources := map[string]interface{}{"limits": map[string]interface{}{"memory": "64Mi"}}
+
+	deployment := func(podAnnotations map[string]interface{}) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata":   map[string]interface{}{"name": "odh-dashboard", "namespace": "opendatahub"},
+			"spec": map[string]interface{}{
+				"template": map[string]interface{}{
+					"metadata": map[string]interface{}{"annotations": podAnnotations},
+					"spec": map[string]interface{}{
+						"containers": []interface{}{
+							map[string]interface{}{"name": "oauth-proxy", "image": "oauth-proxy:latest", "resources": sidecarResources},
+							map[string]interface{}{"name": "odh-dashboard", "image": "odh-dashboard:latest"},
+						},
+					},
+				},
+			},
+		}}
+	}
+
+	config := &components.Config{
+		Resources: &corev1.ResourceRequirements{
+			Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("2Gi")},
+		},
+		ImageOverrides: []components.ImageOverride{{Container: "oauth-proxy", Image: "oauth-proxy:custom"}},
+	}
+
+	containers := func(obj *unstructured.Unstructured) []interface{} {
+		list, found, err := unstructured.NestedSlice(obj.Object, "spec", "template", "spec", "containers")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+
+		return list
+	}
+
+	It("should only set the resources of the main container, keeping the ones of sidecars", func() {
+		obj := deployment(map[string]interface{}{"kubectl.kubernetes.io/default-container": "odh-dashboard"})
+
+		Expect(deploy.CustomizeWorkload(obj, config)).To(Succeed())
+
+		Expect(containers(obj)).To(Equal([]interface{}{
+			map[string]interface{}{"name": "oauth-proxy", "image": "oauth-proxy:custom", "resources": sidecarResources},
+			map[string]interface{}{"name": "odh-dashboard", "image": "odh-dashboard:latest",
+				"resources": map[string]interface{}{"limits": map[string]interface{}{"memory": "2Gi"}}},
+		}))
+	})
+
+	It("should set the resources of the first container when the main container is not named", func() {
+		obj := deployment(nil)
+
+		Expect(deploy.CustomizeWorkload(obj, config)).To(Succeed())
+
+		Expect(containers(obj)[0]).To(HaveKeyWithValue("resources", map[string]interface{}{"limits": map[string]interface{}{"memory": "2Gi"}}))
+		Expect(containers(obj)[1]).ToNot(HaveKey("resources"))
+	})
+})
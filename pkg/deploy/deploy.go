@@ -22,12 +22,12 @@ import (
 	"bufio"
 	"compress/gzip"
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
-	"net/http"
+	"net/url"
 	"os"
+	"path"
 	"path/filepath"
 	"strings"
 
@@ -36,6 +36,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/yaml"
@@ -46,36 +47,44 @@ import (
 	"sigs.k8s.io/kustomize/kyaml/filesys"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/components"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/plugins"
 )
 
 const (
 	DefaultManifestPath = "/opt/manifests"
+	// legacyCreateFieldManager is the field manager the API server recorded for objects created by previous versions of the operator.
+	legacyCreateFieldManager = "manager"
 )
 
 // DownloadManifests function performs following tasks:
-// 1. It takes component URI and only downloads folder specified by component.ContextDir field
-// 2. It saves the manifests in the odh-manifests/component-name/ folder.
-func DownloadManifests(ctx context.Context, componentName string, manifestConfig components.ManifestsConfig) error {
-	// Get the component repo from the given url
-	// e.g.  https://github.com/example/tarball/master
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, manifestConfig.URI, nil)
+// 1. It fetches manifests from component URI using the source matching its scheme: http(s) tarballs, oci:// artifacts or file:// paths
+// 2. It verifies the checksum of archives when component.SHA256 is set
+// 3. It only extracts folder specified by component.ContextDir field
+// 4. It saves the manifests in the target folder, usually a folder of a Workspace.
+func DownloadManifests(ctx context.Context, cli client.Client, target string, manifestConfig components.ManifestsConfig) error {
+	uri, err := url.Parse(manifestConfig.URI)
 	if err != nil {
-		return err
+		return fmt.Errorf("invalid manifests URI %s: %w", manifestConfig.URI, err)
 	}
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return fmt.Errorf("error downloading manifests: %w", err)
+	source, found := manifestsSources[uri.Scheme]
+	if !found {
+		return fmt.Errorf("unsupported scheme %q of manifests URI %s", uri.Scheme, manifestConfig.URI)
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("error downloading manifests: %v HTTP status", resp.StatusCode)
+	return source(ctx, cli, uri, target, manifestConfig)
+}
+
+func extractManifests(archive, target, contextDir string) error {
+	archiveFile, err := os.Open(archive)
+	if err != nil {
+		return fmt.Errorf("error opening manifests archive: %w", err)
 	}
+	defer archiveFile.Close()
 
 	// Create a new gzip reader
-	gzipReader, err := gzip.NewReader(resp.Body)
+	gzipReader, err := gzip.NewReader(archiveFile)
 	if err != nil {
 		return fmt.Errorf("error creating gzip reader: %w", err)
 	}
@@ -86,63 +95,70 @@ func DownloadManifests(ctx context.Context, componentName string, manifestConfig
 
 	// Create manifest directory
 	mode := os.ModePerm
-	err = os.MkdirAll(DefaultManifestPath, mode)
+	err = os.MkdirAll(target, mode)
 	if err != nil {
 		return fmt.Errorf("error creating manifests directory : %w", err)
 	}
 
-	// Extract the contents of the TAR archive to the current directory
+	// Extract the contents of the TAR archive to the target directory
 	for {
 		header, err := tarReader.Next()
 		if errors.Is(err, io.EOF) {
-			break
+			return nil
 		}
 		if err != nil {
 			return err
 		}
 
 		componentFiles := strings.Split(header.Name, "/")
-		componentFileName := header.Name
-		componentManifestPath := componentFiles[0] + "/" + manifestConfig.ContextDir
-
-		if strings.Contains(componentFileName, componentManifestPath) {
-			// Get manifest path relative to repo
-			// e.g. of repo/a/b/manifests/base --> base/
-			componentFoldersList := strings.Split(componentFileName, "/")
-			componentFileRelativePathFound := strings.Join(componentFoldersList[len(strings.Split(componentManifestPath, "/")):], "/")
-
-			if header.Typeflag == tar.TypeDir {
-				err = os.MkdirAll(DefaultManifestPath+"/"+componentName+"/"+componentFileRelativePathFound, mode)
-				if err != nil {
-					return fmt.Errorf("error creating directory:%w", err)
-				}
-
-				continue
-			}
+		componentManifestPath := path.Clean(componentFiles[0] + "/" + contextDir)
 
-			if header.Typeflag == tar.TypeReg {
-				file, err := os.Create(DefaultManifestPath + "/" + componentName + "/" + componentFileRelativePathFound)
-				if err != nil {
-					fmt.Println("Error creating file:", err)
-				}
-				for {
-					_, err := io.CopyN(file, tarReader, 1024)
-					if err != nil {
-						if errors.Is(err, io.EOF) {
-							break
-						}
-						fmt.Println("Error downloading file contents:", err)
-						return err
-					}
-				}
-				file.Close()
-
-				continue
+		// Get manifest path relative to repo
+		// e.g. of repo/a/b/manifests/base --> base/
+		componentFileRelativePathFound, found := strings.CutPrefix(header.Name, componentManifestPath+"/")
+		if !found {
+			continue
+		}
+
+		// Reject entries such as ../../etc/passwd, which would be written outside the target directory
+		filePath := filepath.Join(target, componentFileRelativePathFound)
+		if !isWithin(filePath, target) {
+			return fmt.Errorf("invalid path %s in manifests archive", header.Name)
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(filePath, mode); err != nil {
+				return fmt.Errorf("error creating directory:%w", err)
+			}
+		case tar.TypeReg:
+			if err := extractFile(tarReader, filePath); err != nil {
+				return err
 			}
 		}
 	}
+}
 
-	return err
+func extractFile(reader io.Reader, target string) error {
+	// Archives do not necessarily have entries for all directories
+	if err := os.MkdirAll(filepath.Dir(target), os.ModePerm); err != nil {
+		return fmt.Errorf("error creating directory:%w", err)
+	}
+	file, err := os.Create(target)
+	if err != nil {
+		return fmt.Errorf("error creating file: %w", err)
+	}
+	defer file.Close()
+
+	for {
+		_, err := io.CopyN(file, reader, 1024)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("error extracting file contents: %w", err)
+		}
+	}
 }
 
 func DeployManifestsFromPath(
@@ -187,12 +203,31 @@ func DeployManifestsFromPath(
 	if err != nil {
 		return err
 	}
+	// In plan mode all requests are sent as dry-run, so nothing is persisted in the cluster
+	if PlanFrom(ctx) != nil {
+		cli = client.NewDryRunClient(cli)
+	}
+
+	inventory := inventoryFrom(ctx)
+	renderer := RendererFrom(ctx)
 	// Create / apply / delete resources in the cluster
 	for _, obj := range objs {
+		if componentEnabled {
+			if err := customizeWorkload(obj, componentConfigFrom(ctx)); err != nil {
+				return err
+			}
+		}
+		// In render mode objects are recorded as they are about to be applied
+		if renderer != nil && componentEnabled && !isApplicationNamespace(obj, namespace) {
+			renderer.Record(obj)
+		}
 		err = manageResource(ctx, cli, obj, owner, namespace, componentName, componentEnabled)
 		if err != nil {
 			return err
 		}
+		if inventory != nil && componentEnabled {
+			inventory.record(obj)
+		}
 	}
 
 	return nil
@@ -214,23 +249,38 @@ func GetResources(resMap resmap.ResMap) ([]*unstructured.Unstructured, error) {
 
 func manageResource(ctx context.Context, cli client.Client, obj *unstructured.Unstructured, owner metav1.Object, applicationNamespace, componentName string, enabled bool) error {
 	// Return if resource is of Kind: Namespace and Name: odhApplicationsNamespace
-	if obj.GetKind() == "Namespace" && obj.GetName() == applicationNamespace {
+	if isApplicationNamespace(obj, applicationNamespace) {
 		return nil
 	}
 
 	found, err := getResource(ctx, cli, obj)
+	plan := PlanFrom(ctx)
 
 	// err == nil means found
 	if err == nil {
 		if enabled {
-			return updateResource(ctx, cli, obj, found, owner, componentName)
+			if plan == nil {
+				return updateResource(ctx, cli, obj, found, owner)
+			}
+			if err := updateResource(ctx, cli, obj, found, owner); err != nil {
+				return err
+			}
+			// obj now holds the result of the dry-run apply
+			if fields := changedFields(found, obj); len(fields) != 0 {
+				plan.recordObject(PlanActionUpdate, componentName, obj, fields)
+			}
+
+			return nil
 		}
-		return handleDisabledComponent(ctx, cli, found, componentName)
+		return handleDisabledComponent(ctx, cli, found, owner, applicationNamespace, componentName)
 	}
 
 	if k8serr.IsNotFound(err) {
 		// Create resource if it doesn't exist and enabled
 		if enabled {
+			if plan != nil {
+				plan.recordObject(PlanActionCreate, componentName, obj, nil)
+			}
 			return createResource(ctx, cli, obj, owner)
 		}
 		return nil
@@ -239,6 +289,10 @@ func manageResource(ctx context.Context, cli client.Client, obj *unstructured.Un
 	return err
 }
 
+func isApplicationNamespace(obj *unstructured.Unstructured, applicationNamespace string) bool {
+	return obj.GetKind() == "Namespace" && obj.GetName() == applicationNamespace
+}
+
 /*
 User env variable passed from CSV (if it is set) to overwrite values from manifests' params.env file
 This is useful for air gapped cluster
@@ -249,6 +303,37 @@ priority of image values (from high to low):
 parameter isUpdateNamespace is used to set if should update namespace  with dsci applicationnamespace.
 */
 func ApplyParams(componentPath string, imageParamsMap map[string]string, isUpdateNamespace bool) error {
+	return updateParams(componentPath, func(envMap map[string]string) {
+		// Update images with env variables
+		// e.g "odh-kuberay-operator-controller-image": "RELATED_IMAGE_ODH_KUBERAY_OPERATOR_CONTROLLER_IMAGE",
+		for i := range envMap {
+			relatedImageValue := os.Getenv(imageParamsMap[i])
+			if relatedImageValue != "" {
+				envMap[i] = relatedImageValue
+			}
+		}
+
+		// Update namespace variable with applicationNamepsace
+		if isUpdateNamespace {
+			envMap["namespace"] = imageParamsMap["namespace"]
+		}
+	})
+}
+
+// SetParams sets the values of the given params in the params.env file at the root of componentPath. Params missing
+// from the file are not added, as the kustomization does not refer to them.
+func SetParams(componentPath string, params map[string]string) error {
+	return updateParams(componentPath, func(envMap map[string]string) {
+		for key := range envMap {
+			if value, found := params[key]; found && value != "" {
+				envMap[key] = value
+			}
+		}
+	})
+}
+
+// updateParams rewrites the params.env file at the root of componentPath with the params changed by update.
+func updateParams(componentPath string, update func(envMap map[string]string)) error {
 	envFilePath := filepath.Join(componentPath, "params.env")
 	// Require params.env at the root folder
 	file, err := os.Open(envFilePath)
@@ -277,19 +362,7 @@ func ApplyParams(componentPath string, imageParamsMap map[string]string, isUpdat
 		return err
 	}
 
-	// Update images with env variables
-	// e.g "odh-kuberay-operator-controller-image": "RELATED_IMAGE_ODH_KUBERAY_OPERATOR_CONTROLLER_IMAGE",
-	for i := range envMap {
-		relatedImageValue := os.Getenv(imageParamsMap[i])
-		if relatedImageValue != "" {
-			envMap[i] = relatedImageValue
-		}
-	}
-
-	// Update namespace variable with applicationNamepsace
-	if isUpdateNamespace {
-		envMap["namespace"] = imageParamsMap["namespace"]
-	}
+	update(envMap)
 
 	// Move the existing file to a backup file and create empty file
 	if err := os.Rename(envFilePath, backupPath); err != nil {
@@ -328,44 +401,6 @@ func ApplyParams(componentPath string, imageParamsMap map[string]string, isUpdat
 	return err
 }
 
-// removeResourcesFromDeployment checks if the provided resource is a Deployment,
-// and if so, removes the resources field from each container in the Deployment. This ensures we do not overwrite the
-// resources field when Patch is applied with the returned unstructured resource.
-func removeResourcesFromDeployment(u *unstructured.Unstructured) error {
-	// Check if the resource is a Deployment. This can be expanded to other resources as well.
-	if u.GetKind() != "Deployment" {
-		return nil
-	}
-	// Navigate to the containers array in the Deployment spec
-	containers, exists, err := unstructured.NestedSlice(u.Object, "spec", "template", "spec", "containers")
-	if err != nil {
-		return fmt.Errorf("error when trying to retrieve containers from Deployment: %w", err)
-	}
-	// Return if no containers exist
-	if !exists {
-		return nil
-	}
-
-	// Iterate over the containers to remove the resources field
-	for i := range containers {
-		container, ok := containers[i].(map[string]interface{})
-		// If containers field is not in expected type, return.
-		if !ok {
-			return nil
-		}
-		// Check and delete the resources field. This can be expanded to any whitelisted field.
-		delete(container, "resources")
-		containers[i] = container
-	}
-
-	// Update the containers in the original unstructured object
-	if err := unstructured.SetNestedSlice(u.Object, containers, "spec", "template", "spec", "containers"); err != nil {
-		return fmt.Errorf("failed to update containers in Deployment: %w", err)
-	}
-
-	return nil
-}
-
 func getResource(ctx context.Context, cli client.Client, obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
 	found := &unstructured.Unstructured{}
 	// Setting gvk is required to do Get request
@@ -381,13 +416,18 @@ func getResource(ctx context.Context, cli client.Client, obj *unstructured.Unstr
 	return found, nil
 }
 
-func handleDisabledComponent(ctx context.Context, cli client.Client, found *unstructured.Unstructured, componentName string) error {
+func handleDisabledComponent(ctx context.Context, cli client.Client, found *unstructured.Unstructured, owner metav1.Object,
+	applicationNamespace, componentName string,
+) error {
 	resourceLabels := found.GetLabels()
 	componentCounter := getComponentCounter(resourceLabels)
 
 	if isSharedResource(componentCounter, componentName) || found.GetKind() == "CustomResourceDefinition" {
 		return nil
 	}
+	if released, err := releaseSharedResource(ctx, cli, found, owner, applicationNamespace, componentName); released || err != nil {
+		return err
+	}
 
 	return deleteResource(ctx, cli, found, componentName)
 }
@@ -413,6 +453,9 @@ func deleteResource(ctx context.Context, cli client.Client, found *unstructured.
 	resourceLabels := found.GetLabels()
 
 	if isOwnedByODHCRD(existingOwnerReferences) || resourceLabels[selector] == "true" {
+		if plan := PlanFrom(ctx); plan != nil {
+			plan.recordObject(PlanActionDelete, componentName, found, nil)
+		}
 		return cli.Delete(ctx, found)
 	}
 	return nil
@@ -428,21 +471,26 @@ func isOwnedByODHCRD(ownerReferences []metav1.OwnerReference) bool {
 }
 
 func createResource(ctx context.Context, cli client.Client, obj *unstructured.Unstructured, owner metav1.Object) error {
-	if obj.GetKind() != "CustomResourceDefinition" && obj.GetKind() != "OdhDashboardConfig" {
-		if err := ctrl.SetControllerReference(owner, metav1.Object(obj), cli.Scheme()); err != nil {
-			return err
-		}
+	if err := setOwner(obj, nil, owner, cli.Scheme()); err != nil {
+		return err
 	}
-	return cli.Create(ctx, obj)
+
+	return applyResource(ctx, cli, obj, owner)
 }
 
-func skipUpdateOnWhitelistedFields(obj *unstructured.Unstructured, componentName string) error {
-	if componentName == "kserve" || componentName == "model-mesh" {
-		if err := removeResourcesFromDeployment(obj); err != nil {
-			return err
+// setOwner makes the owner controller of new objects, unless they are meant to outlive it. Existing objects keep the owner reference
+// they were created with: it is part of every apply, otherwise it would be removed from objects created with the same field manager.
+func setOwner(obj, found *unstructured.Unstructured, owner metav1.Object, scheme *runtime.Scheme) error {
+	if obj.GetKind() == "CustomResourceDefinition" || obj.GetKind() == "OdhDashboardConfig" {
+		return nil
+	}
+	if found != nil {
+		if controller := metav1.GetControllerOf(found); controller == nil || controller.UID != owner.GetUID() {
+			return nil
 		}
 	}
-	return nil
+
+	return ctrl.SetControllerReference(owner, metav1.Object(obj), scheme)
 }
 
 func updateLabels(found, obj *unstructured.Unstructured) {
@@ -457,28 +505,47 @@ func updateLabels(found, obj *unstructured.Unstructured) {
 	obj.SetLabels(foundLabels)
 }
 
-func performPatch(ctx context.Context, cli client.Client, obj, found *unstructured.Unstructured, owner metav1.Object) error {
-	data, err := json.Marshal(obj)
-	if err != nil {
-		return err
-	}
-	return cli.Patch(ctx, found, client.RawPatch(types.ApplyPatchType, data), client.ForceOwnership, client.FieldOwner(owner.GetName()))
+// applyResource applies the object using the operator field manager. Fields previously managed under the name of the owner
+// or by plain create requests are taken over.
+func applyResource(ctx context.Context, cli client.Client, obj *unstructured.Unstructured, owner metav1.Object) error {
+	return cluster.Apply(ctx, cli, obj, cluster.TakeOverFrom(owner.GetName(), legacyCreateFieldManager))
 }
 
-func updateResource(ctx context.Context, cli client.Client, obj, found *unstructured.Unstructured, owner metav1.Object, componentName string) error {
+func updateResource(ctx context.Context, cli client.Client, obj, found *unstructured.Unstructured, owner metav1.Object) error {
 	// Skip ODHDashboardConfig Update
 	if found.GetKind() == "OdhDashboardConfig" {
+		obj.Object = found.DeepCopy().Object
+
 		return nil
 	}
-	// skip updating whitelisted fields
-	if err := skipUpdateOnWhitelistedFields(obj, componentName); err != nil {
+	// skip updating preserved fields
+	if err := preserveFields(obj, found, preservedFieldsFrom(ctx)); err != nil {
+		return err
+	}
+	// configured fields are set again, as they take precedence over preserved ones
+	if err := customizeWorkload(obj, componentConfigFrom(ctx)); err != nil {
 		return err
 	}
 
 	// Retain existing labels on update
 	updateLabels(found, obj)
 
-	return performPatch(ctx, cli, obj, found, owner)
+	shared, err := sharedWithOwner(found, owner, cli.Scheme())
+	if err != nil {
+		return err
+	}
+	if shared {
+		if err := mergeSubjects(obj, found); err != nil {
+			return err
+		}
+		if err := setSharedOwner(obj, found, owner, cli.Scheme()); err != nil {
+			return err
+		}
+	} else if err := setOwner(obj, found, owner, cli.Scheme()); err != nil {
+		return err
+	}
+
+	return applyResource(ctx, cli, obj, owner)
 }
 
 // TODO : Add function to cleanup code created as part of pre install and post install task of a component
@@ -0,0 +1,102 @@
+package deploy_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+
+	"github.com/onsi/gomega/types"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// archiveEntry is a file, or a directory when content is nil, of a manifests archive.
+type archiveEntry struct {
+	name    string
+	content []byte
+}
+
+func archiveOf(entries ...archiveEntry) []byte {
+	buffer := &bytes.Buffer{}
+	gzipWriter := gzip.NewWriter(buffer)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for _, entry := range entries {
+		header := &tar.Header{Name: entry.name, Mode: 0o644, Typeflag: tar.TypeReg, Size: int64(len(entry.content))}
+		if entry.content == nil {
+			header.Typeflag = tar.TypeDir
+			header.Mode = 0o755
+		}
+		Expect(tarWriter.WriteHeader(header)).To(Succeed())
+		_, err := tarWriter.Write(entry.content)
+		Expect(err).ToNot(HaveOccurred())
+	}
+	Expect(tarWriter.Close()).To(Succeed())
+	Expect(gzipWriter.Close()).To(Succeed())
+
+	return buffer.Bytes()
+}
+
+var _ = Describe("Extracting manifests archives", func() {
+
+	var dir, target string
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+		target = filepath.Join(dir, "target")
+	})
+
+	extract := func(contextDir string, entries ...archiveEntry) error {
+		archive := filepath.Join(dir, "manifests.tar.gz")
+		Expect(os.WriteFile(archive, archiveOf(entries...), 0o600)).To(Succeed())
+
+		return deploy.ExtractManifests(archive, target, contextDir)
+	}
+
+	It("should only extract the context directory of the top level folder", func() {
+		Expect(extract("manifests",
+			archiveEntry{name: "repo-1234/"},
+			archiveEntry{name: "repo-1234/manifests/"},
+			archiveEntry{name: "repo-1234/manifests/base/kustomization.yaml", content: []byte("resources: []")},
+			archiveEntry{name: "repo-1234/README.md", content: []byte("readme")},
+		)).To(Succeed())
+
+		Expect(filepath.Join(target, "base", "kustomization.yaml")).To(BeARegularFile())
+		Expect(filepath.Join(target, "README.md")).ToNot(BeAnExistingFile())
+	})
+
+	It("should extract files without entries for their directories", func() {
+		Expect(extract("manifests",
+			archiveEntry{name: "repo-1234/manifests/overlays/odh/params.env", content: []byte("image=quay.io/org/image")},
+		)).To(Succeed())
+
+		Expect(os.ReadFile(filepath.Join(target, "overlays", "odh", "params.env"))).To(Equal([]byte("image=quay.io/org/image")))
+	})
+
+	DescribeTable("should not write entries outside of the target directory",
+		func(name string, matchErr types.GomegaMatcher, outside string) {
+			Expect(extract("manifests", archiveEntry{name: name, content: []byte("evil")})).To(matchErr)
+			Expect(filepath.Join(dir, outside)).ToNot(BeAnExistingFile())
+		},
+		Entry("with a relative path escaping the target", "repo-1234/manifests/../../evil", HaveOccurred(), "evil"),
+		Entry("with a deeply relative path", "repo-1234/manifests/base/../../../../evil", HaveOccurred(), "evil"),
+		Entry("with an absolute path", "/repo-1234/manifests/evil", Succeed(), "evil"),
+		Entry("with a folder sharing the prefix of the context directory", "repo-1234/manifests-extra/evil", Succeed(), "evil"),
+		Entry("with the context directory nested in another folder", "repo-1234/other/repo-1234/manifests/evil", Succeed(), "evil"),
+	)
+
+	It("should skip entries of folders sharing the prefix of the context directory", func() {
+		Expect(extract("manifests",
+			archiveEntry{name: "repo-1234/manifests/base/kustomization.yaml", content: []byte("resources: []")},
+			archiveEntry{name: "repo-1234/manifests-extra/base/other.yaml", content: []byte("kind: ConfigMap")},
+		)).To(Succeed())
+
+		Expect(filepath.Join(target, "base", "kustomization.yaml")).To(BeARegularFile())
+		Expect(filepath.Join(target, "base", "other.yaml")).ToNot(BeAnExistingFile())
+		Expect(filepath.Join(target, "-extra")).ToNot(BeAnExistingFile())
+	})
+})
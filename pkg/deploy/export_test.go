@@ -0,0 +1,69 @@
+package deploy
+
+import (
+	"context"
+	"net/url"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/components"
+)
+
+// Helpers exposing unexported functions to the tests of the package.
+
+func RecordObject(inventory *Inventory, obj *unstructured.Unstructured) {
+	inventory.record(obj)
+}
+
+func RecordManifestsSource(inventory *Inventory, uri string) {
+	inventory.recordManifestsSource(uri)
+}
+
+func ExtractManifests(archive, target, contextDir string) error {
+	return extractManifests(archive, target, contextDir)
+}
+
+func FetchArchive(ctx context.Context, cache *ManifestsCache, manifestConfig components.ManifestsConfig) (string, error) {
+	return cache.fetchArchive(ctx, manifestConfig)
+}
+
+func EvictManifestsCache(cache *ManifestsCache) error {
+	return cache.evict()
+}
+
+// NewWorkspaceFrom creates a workspace from the given manifests tree instead of DefaultManifestPath.
+func NewWorkspaceFrom(pristine, componentName string, folders ...string) (*Workspace, error) {
+	return newWorkspace(pristine, componentName, folders...)
+}
+
+func ChangedFields(live, updated *unstructured.Unstructured) []string {
+	return changedFields(live, updated)
+}
+
+func ManifestsCacheFrom(ctx context.Context) (*ManifestsCache, func(), error) {
+	return manifestsCacheFrom(ctx)
+}
+
+func CustomizeWorkload(obj *unstructured.Unstructured, config *components.Config) error {
+	return customizeWorkload(obj, config)
+}
+
+func PreserveFields(obj, found *unstructured.Unstructured, policies []infrav1.FieldPreservationPolicy) error {
+	return preserveFields(obj, found, policies)
+}
+
+var (
+	SplitChallengeParams = splitChallengeParams
+	RegistryCredentials  = registryCredentials
+)
+
+func FetchManifests(ctx context.Context, cli client.Client, target string, manifestConfig components.ManifestsConfig) error {
+	uri, err := url.Parse(manifestConfig.URI)
+	if err != nil {
+		return err
+	}
+
+	return manifestsSources[uri.Scheme](ctx, cli, uri, target, manifestConfig)
+}
@@ -0,0 +1,144 @@
+package deploy
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+type inventoryKey struct{}
+
+// Inventory collects the objects and images deployed by DeployManifestsFromPath while reconciling a component, along with
+// the URIs of the manifests downloaded into its workspace.
+// It is attached to the context using WithInventory, so it can be filled without changing signatures of component functions.
+type Inventory struct {
+	mu      sync.Mutex
+	objects []corev1.ObjectReference
+	images  map[string]struct{}
+	sources []string
+}
+
+func NewInventory() *Inventory {
+	return &Inventory{images: map[string]struct{}{}}
+}
+
+// WithInventory returns a context which makes DeployManifestsFromPath record applied objects in the given inventory.
+func WithInventory(ctx context.Context, inventory *Inventory) context.Context {
+	return context.WithValue(ctx, inventoryKey{}, inventory)
+}
+
+func inventoryFrom(ctx context.Context) *Inventory {
+	inventory, _ := ctx.Value(inventoryKey{}).(*Inventory)
+
+	return inventory
+}
+
+// Objects returns references to all recorded objects, in the order they have been applied.
+func (i *Inventory) Objects() []corev1.ObjectReference {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	return append([]corev1.ObjectReference(nil), i.objects...)
+}
+
+// Workloads returns references to the recorded Deployments and StatefulSets, which make the component ready.
+func (i *Inventory) Workloads() []corev1.ObjectReference {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	var workloads []corev1.ObjectReference
+	for _, obj := range i.objects {
+		if obj.APIVersion == "apps/v1" && (obj.Kind == "Deployment" || obj.Kind == "StatefulSet") {
+			workloads = append(workloads, obj)
+		}
+	}
+
+	return workloads
+}
+
+// Images returns the sorted list of container images used by the recorded workloads.
+func (i *Inventory) Images() []string {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	images := make([]string, 0, len(i.images))
+	for image := range i.images {
+		images = append(images, image)
+	}
+	sort.Strings(images)
+
+	return images
+}
+
+// ManifestsSources returns the URIs of the manifests used in place of the built-in ones, empty when the component has been
+// deployed from the manifests shipped with the operator.
+func (i *Inventory) ManifestsSources() []string {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	return append([]string(nil), i.sources...)
+}
+
+func (i *Inventory) recordManifestsSource(uri string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	for _, source := range i.sources {
+		if source == uri {
+			return
+		}
+	}
+	i.sources = append(i.sources, uri)
+}
+
+func (i *Inventory) record(obj *unstructured.Unstructured) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.objects = append(i.objects, corev1.ObjectReference{
+		APIVersion: obj.GetAPIVersion(),
+		Kind:       obj.GetKind(),
+		Namespace:  obj.GetNamespace(),
+		Name:       obj.GetName(),
+	})
+
+	for _, image := range containerImages(obj) {
+		i.images[image] = struct{}{}
+	}
+}
+
+// containerImages returns images of all containers defined in the pod template of a workload.
+// Images are taken from the rendered manifests, therefore they reflect values substituted by ApplyParams.
+func containerImages(obj *unstructured.Unstructured) []string {
+	var podSpecPath []string
+	switch obj.GetKind() {
+	case "Deployment", "StatefulSet", "DaemonSet", "ReplicaSet", "Job":
+		podSpecPath = []string{"spec", "template", "spec"}
+	case "CronJob":
+		podSpecPath = []string{"spec", "jobTemplate", "spec", "template", "spec"}
+	default:
+		return nil
+	}
+
+	var images []string
+	for _, field := range []string{"initContainers", "containers"} {
+		containers, _, err := unstructured.NestedSlice(obj.Object, append(podSpecPath, field)...)
+		if err != nil {
+			continue
+		}
+		for _, container := range containers {
+			containerMap, ok := container.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if image, found, err := unstructured.NestedString(containerMap, "image"); err == nil && found && image != "" {
+				images = append(images, image)
+			}
+		}
+	}
+
+	return images
+}
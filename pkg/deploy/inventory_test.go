@@ -0,0 +1,89 @@
+package deploy_test
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Recording deployed objects in the inventory", func() {
+
+	workload := func(kind string, podSpecPath []string, images ...string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion("apps/v1")
+		obj.SetKind(kind)
+		obj.SetNamespace("opendatahub")
+		obj.SetName("test-" + kind)
+		var containers []interface{}
+		for _, image := range images {
+			containers = append(containers, map[string]interface{}{"name": "c", "image": image})
+		}
+		Expect(unstructured.SetNestedSlice(obj.Object, containers, append(podSpecPath, "containers")...)).To(Succeed())
+
+		return obj
+	}
+
+	It("should list recorded objects in the order they have been applied", func() {
+		inventory := deploy.NewInventory()
+		service := &unstructured.Unstructured{}
+		service.SetAPIVersion("v1")
+		service.SetKind("Service")
+		service.SetNamespace("opendatahub")
+		service.SetName("dashboard")
+
+		deploy.RecordObject(inventory, service)
+		deploy.RecordObject(inventory, workload("Deployment", []string{"spec", "template", "spec"}, "quay.io/dashboard:v1"))
+
+		Expect(inventory.Objects()).To(Equal([]corev1.ObjectReference{
+			{APIVersion: "v1", Kind: "Service", Namespace: "opendatahub", Name: "dashboard"},
+			{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "opendatahub", Name: "test-Deployment"},
+		}))
+		Expect(inventory.Workloads()).To(Equal([]corev1.ObjectReference{
+			{APIVersion: "apps/v1", Kind: "Deployment", Namespace: "opendatahub", Name: "test-Deployment"},
+		}))
+	})
+
+	It("should collect sorted and unique images of workloads", func() {
+		inventory := deploy.NewInventory()
+
+		deploy.RecordObject(inventory, workload("Deployment", []string{"spec", "template", "spec"}, "quay.io/b:v1", "quay.io/a:v1"))
+		deploy.RecordObject(inventory, workload("StatefulSet", []string{"spec", "template", "spec"}, "quay.io/a:v1"))
+		deploy.RecordObject(inventory, workload("CronJob", []string{"spec", "jobTemplate", "spec", "template", "spec"}, "quay.io/c:v1"))
+
+		Expect(inventory.Images()).To(Equal([]string{"quay.io/a:v1", "quay.io/b:v1", "quay.io/c:v1"}))
+	})
+
+	It("should collect images of init containers", func() {
+		inventory := deploy.NewInventory()
+		job := workload("Job", []string{"spec", "template", "spec"}, "quay.io/job:v1")
+		Expect(unstructured.SetNestedSlice(job.Object, []interface{}{map[string]interface{}{"name": "init", "image": "quay.io/init:v1"}},
+			"spec", "template", "spec", "initContainers")).To(Succeed())
+
+		deploy.RecordObject(inventory, job)
+
+		Expect(inventory.Images()).To(Equal([]string{"quay.io/init:v1", "quay.io/job:v1"}))
+	})
+
+	It("should not collect images of objects other than workloads", func() {
+		inventory := deploy.NewInventory()
+
+		deploy.RecordObject(inventory, workload("Pod", []string{"spec"}, "quay.io/pod:v1"))
+
+		Expect(inventory.Images()).To(BeEmpty())
+		Expect(inventory.Workloads()).To(BeEmpty())
+	})
+
+	It("should record each manifests source once", func() {
+		inventory := deploy.NewInventory()
+
+		deploy.RecordManifestsSource(inventory, "https://example.com/manifests.tar.gz")
+		deploy.RecordManifestsSource(inventory, "oci://quay.io/manifests:v1")
+		deploy.RecordManifestsSource(inventory, "https://example.com/manifests.tar.gz")
+
+		Expect(inventory.ManifestsSources()).To(Equal([]string{"https://example.com/manifests.tar.gz", "oci://quay.io/manifests:v1"}))
+	})
+})
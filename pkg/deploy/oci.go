@@ -0,0 +1,408 @@
+package deploy
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/components"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+)
+
+// +kubebuilder:rbac:groups="config.openshift.io",resources=images,verbs=get
+
+// Media types of the manifest describing an artifact, and of the layer holding the manifests archive.
+var (
+	ociManifestMediaTypes = []string{
+		"application/vnd.oci.image.manifest.v1+json",
+		"application/vnd.docker.distribution.manifest.v2+json",
+	}
+	ociArchiveMediaTypes = map[string]bool{
+		"application/vnd.oci.image.layer.v1.tar+gzip":       true,
+		"application/vnd.docker.image.rootfs.diff.tar.gzip": true,
+		"application/tar+gzip":                              true,
+	}
+)
+
+// ociReference is an artifact in an OCI registry, e.g. oci://quay.io/org/dashboard-manifests:v2.10.
+type ociReference struct {
+	registry   string
+	repository string
+	// reference is either a tag or a digest
+	reference string
+}
+
+func parseOCIReference(uri *url.URL) (ociReference, error) {
+	ref := ociReference{registry: uri.Host, repository: strings.TrimPrefix(uri.Path, "/"), reference: "latest"}
+	if i := strings.Index(ref.repository, "@"); i >= 0 {
+		ref.repository, ref.reference = ref.repository[:i], ref.repository[i+1:]
+	} else if i := strings.LastIndex(ref.repository, ":"); i > strings.LastIndex(ref.repository, "/") {
+		ref.repository, ref.reference = ref.repository[:i], ref.repository[i+1:]
+	}
+	if ref.registry == "" || ref.repository == "" || ref.reference == "" {
+		return ref, fmt.Errorf("invalid OCI reference %s", uri)
+	}
+
+	return ref, nil
+}
+
+type ociManifest struct {
+	Layers []ociDescriptor `json:"layers"`
+}
+
+type ociDescriptor struct {
+	MediaType string `json:"mediaType"`
+	Digest    string `json:"digest"`
+}
+
+// fromOCI extracts manifests from the archive pushed as the layer of an OCI artifact, e.g. with
+// oras push quay.io/org/dashboard-manifests:v2.10 manifests.tar.gz:application/vnd.oci.image.layer.v1.tar+gzip.
+// Credentials of the registry are read from the docker config pull secrets of the operator namespace.
+func fromOCI(ctx context.Context, cli client.Client, uri *url.URL, target string, manifestConfig components.ManifestsConfig) error {
+	ref, err := parseOCIReference(uri)
+	if err != nil {
+		return err
+	}
+
+	registry, err := newRegistryClient(ctx, cli, ref.registry)
+	if err != nil {
+		return err
+	}
+	// Artifacts are pulled anonymously when the operator does not run in a cluster
+	if namespace, err := cluster.GetOperatorNamespace(); err == nil {
+		if registry.username, registry.password, err = registryCredentials(ctx, cli, namespace, ref.registry); err != nil {
+			return err
+		}
+	}
+
+	layer, err := registry.archiveLayer(ctx, ref)
+	if err != nil {
+		return fmt.Errorf("error pulling manifests from %s: %w", manifestConfig.URI, err)
+	}
+	digest := strings.TrimPrefix(layer.Digest, "sha256:")
+	if manifestConfig.SHA256 != "" && !strings.EqualFold(manifestConfig.SHA256, digest) {
+		return fmt.Errorf("checksum mismatch of %s: expected sha256 %s, got %s", manifestConfig.URI, manifestConfig.SHA256, digest)
+	}
+
+	cache, cleanup, err := manifestsCacheFrom(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	// Layers are content addressed, so the cached archive is used as long as the artifact does not change
+	if !cache.use(digest) {
+		if err := registry.pullBlob(ctx, cache, ref, digest); err != nil {
+			return fmt.Errorf("error pulling manifests from %s: %w", manifestConfig.URI, err)
+		}
+	}
+
+	return extractManifests(cache.archivePath(digest), target, manifestConfig.ContextDir)
+}
+
+// registryClient implements the part of the OCI distribution API needed to pull artifacts.
+type registryClient struct {
+	registry string
+	// scheme is https, unless an insecure registry only serves http
+	scheme   string
+	insecure bool
+	client   *http.Client
+	username string
+	password string
+	token    string
+}
+
+// newRegistryClient creates a client of the registry trusting the custom CA bundle of the DSCInitialization, in addition
+// to the system ones. Registries listed as insecure in the image configuration of the cluster are accessed without
+// verifying their certificate, or over http when they do not serve https.
+func newRegistryClient(ctx context.Context, cli client.Client, registry string) (*registryClient, error) {
+	rootCAs, err := trustedCAs(ctx, cli)
+	if err != nil {
+		return nil, err
+	}
+	insecure, err := insecureRegistry(ctx, cli, registry)
+	if err != nil {
+		return nil, err
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone() //nolint:forcetypeassert
+	transport.TLSClientConfig = &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		RootCAs:            rootCAs,
+		InsecureSkipVerify: insecure, //nolint:gosec
+	}
+
+	return &registryClient{
+		registry: registry,
+		scheme:   "https",
+		insecure: insecure,
+		client:   &http.Client{Transport: transport, Timeout: manifestsClient.Timeout},
+	}, nil
+}
+
+// trustedCAs returns the system CAs along with the custom CA bundle of the DSCInitialization, when it is managed.
+func trustedCAs(ctx context.Context, cli client.Client) (*x509.CertPool, error) {
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+
+	instances := &dsciv1.DSCInitializationList{}
+	if err := cli.List(ctx, instances); err != nil {
+		return nil, fmt.Errorf("error listing DSCInitializations: %w", err)
+	}
+	for _, instance := range instances.Items {
+		bundle := instance.Spec.TrustedCABundle
+		if bundle == nil || bundle.ManagementState != operatorv1.Managed || strings.TrimSpace(bundle.CustomCABundle) == "" {
+			continue
+		}
+		if !pool.AppendCertsFromPEM([]byte(bundle.CustomCABundle)) {
+			return nil, fmt.Errorf("invalid custom CA bundle in DSCInitialization %s", instance.Name)
+		}
+	}
+
+	return pool, nil
+}
+
+// insecureRegistry tells whether the registry is listed in the insecure registries of the image configuration of the
+// cluster, either by host or with a wildcard domain such as *.example.com.
+func insecureRegistry(ctx context.Context, cli client.Client, registry string) (bool, error) {
+	config := &unstructured.Unstructured{}
+	config.SetGroupVersionKind(gvk.OpenshiftImageConfig)
+	if err := cli.Get(ctx, client.ObjectKey{Name: "cluster"}, config); err != nil {
+		if k8serr.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("error reading image configuration of the cluster: %w", err)
+	}
+
+	insecureRegistries, _, err := unstructured.NestedStringSlice(config.Object, "spec", "registrySources", "insecureRegistries")
+	if err != nil {
+		return false, fmt.Errorf("error reading insecure registries of the cluster: %w", err)
+	}
+	for _, insecure := range insecureRegistries {
+		if insecure == registry || (strings.HasPrefix(insecure, "*.") && strings.HasSuffix(registry, insecure[1:])) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (r *registryClient) archiveLayer(ctx context.Context, ref ociReference) (ociDescriptor, error) {
+	resp, err := r.get(ctx, ref.repository+"/manifests/"+ref.reference, ociManifestMediaTypes...)
+	if err != nil {
+		return ociDescriptor{}, err
+	}
+	defer resp.Body.Close()
+
+	manifest := ociManifest{}
+	if err := json.NewDecoder(resp.Body).Decode(&manifest); err != nil {
+		return ociDescriptor{}, fmt.Errorf("error decoding artifact manifest: %w", err)
+	}
+	for _, layer := range manifest.Layers {
+		if ociArchiveMediaTypes[layer.MediaType] && strings.HasPrefix(layer.Digest, "sha256:") {
+			return layer, nil
+		}
+	}
+
+	return ociDescriptor{}, fmt.Errorf("artifact %s/%s:%s has no tar+gzip layer", ref.registry, ref.repository, ref.reference)
+}
+
+func (r *registryClient) pullBlob(ctx context.Context, cache *ManifestsCache, ref ociReference, digest string) error {
+	resp, err := r.get(ctx, ref.repository+"/blobs/sha256:"+digest)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	_, err = cache.storeArchive(resp.Body, digest)
+
+	return err
+}
+
+// get requests the given path of the registry API, authenticating when the registry asks for it.
+func (r *registryClient) get(ctx context.Context, path string, accept ...string) (*http.Response, error) {
+	resp, err := r.do(ctx, r.url(path), accept)
+	if errors.Is(err, http.ErrSchemeMismatch) && r.insecure {
+		r.scheme = "http"
+		resp, err = r.do(ctx, r.url(path), accept)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized && r.token == "" {
+		challenge := resp.Header.Get("WWW-Authenticate")
+		resp.Body.Close()
+		if err := r.authenticate(ctx, challenge); err != nil {
+			return nil, err
+		}
+		if resp, err = r.do(ctx, r.url(path), accept); err != nil {
+			return nil, err
+		}
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+
+		return nil, fmt.Errorf("error requesting %s: %v HTTP status", path, resp.StatusCode)
+	}
+
+	return resp, nil
+}
+
+func (r *registryClient) url(path string) string {
+	return r.scheme + "://" + r.registry + "/v2/" + path
+}
+
+func (r *registryClient) do(ctx context.Context, target string, accept []string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(accept) != 0 {
+		req.Header.Set("Accept", strings.Join(accept, ", "))
+	}
+	switch {
+	case r.token != "":
+		req.Header.Set("Authorization", "Bearer "+r.token)
+	case r.username != "":
+		req.SetBasicAuth(r.username, r.password)
+	}
+
+	return r.client.Do(req)
+}
+
+// authenticate requests a token as described by the bearer challenge of the registry.
+func (r *registryClient) authenticate(ctx context.Context, challenge string) error {
+	scheme, params, _ := strings.Cut(challenge, " ")
+	if !strings.EqualFold(scheme, "Bearer") {
+		return fmt.Errorf("unauthorized to pull from %s", r.registry)
+	}
+
+	realm := ""
+	query := url.Values{}
+	for _, param := range splitChallengeParams(params) {
+		key, value, _ := strings.Cut(param, "=")
+		value = strings.Trim(value, `"`)
+		if key == "realm" {
+			realm = value
+		} else {
+			query.Set(key, value)
+		}
+	}
+	if realm == "" {
+		return fmt.Errorf("invalid authentication challenge of %s: %s", r.registry, challenge)
+	}
+
+	resp, err := r.do(ctx, realm+"?"+query.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("error authenticating to %s: %v HTTP status", r.registry, resp.StatusCode)
+	}
+
+	token := struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}{}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return fmt.Errorf("error decoding token of %s: %w", r.registry, err)
+	}
+	r.token = token.Token
+	if r.token == "" {
+		r.token = token.AccessToken
+	}
+
+	return nil
+}
+
+// splitChallengeParams splits the comma separated parameters of a challenge, ignoring commas in quoted values
+// such as scope="repository:org/repo:pull,push".
+func splitChallengeParams(params string) []string {
+	var result []string
+	inString := false
+	start := 0
+	for i, c := range params {
+		switch {
+		case c == '"':
+			inString = !inString
+		case c == ',' && !inString:
+			result = append(result, strings.TrimSpace(params[start:i]))
+			start = i + 1
+		}
+	}
+
+	return append(result, strings.TrimSpace(params[start:]))
+}
+
+type dockerConfig struct {
+	Auths map[string]dockerAuth `json:"auths"`
+}
+
+type dockerAuth struct {
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	Auth     string `json:"auth,omitempty"`
+}
+
+// registryCredentials returns the credentials of the registry found in the docker config pull secrets of the namespace.
+// Artifacts are pulled anonymously when there are none.
+func registryCredentials(ctx context.Context, cli client.Client, namespace, registry string) (string, string, error) {
+	secrets := &corev1.SecretList{}
+	if err := cli.List(ctx, secrets, client.InNamespace(namespace)); err != nil {
+		return "", "", fmt.Errorf("error listing pull secrets: %w", err)
+	}
+	for _, secret := range secrets.Items {
+		if secret.Type != corev1.SecretTypeDockerConfigJson {
+			continue
+		}
+		config := dockerConfig{}
+		if err := json.Unmarshal(secret.Data[corev1.DockerConfigJsonKey], &config); err != nil {
+			continue
+		}
+		for server, auth := range config.Auths {
+			if registryHost(server) != registry {
+				continue
+			}
+			if auth.Auth == "" {
+				return auth.Username, auth.Password, nil
+			}
+			decoded, err := base64.StdEncoding.DecodeString(auth.Auth)
+			if err != nil {
+				return "", "", fmt.Errorf("invalid credentials of %s in secret %s: %w", server, secret.Name, err)
+			}
+			username, password, _ := strings.Cut(string(decoded), ":")
+
+			return username, password, nil
+		}
+	}
+
+	return "", "", nil
+}
+
+// registryHost strips the scheme and path of docker config servers, e.g. https://index.docker.io/v1/.
+func registryHost(server string) string {
+	host := strings.TrimPrefix(strings.TrimPrefix(server, "https://"), "http://")
+	host, _, _ = strings.Cut(host, "/")
+
+	return host
+}
@@ -0,0 +1,274 @@
+package deploy_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/components"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Pulling manifests from OCI registries", func() {
+
+	const token = "registry-token"
+
+	var (
+		ctx     context.Context
+		scheme  *runtime.Scheme
+		target  string
+		archive []byte
+		digest  string
+		layers  []map[string]string
+	)
+
+	registryHandler := func(registry func() string) http.Handler {
+		mux := http.NewServeMux()
+		mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("scope") != "repository:org/manifests:pull" || r.URL.Query().Get("service") != "registry" {
+				w.WriteHeader(http.StatusBadRequest)
+
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]string{"token": token})
+		})
+		mux.HandleFunc("/v2/", func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "Bearer "+token {
+				w.Header().Set("WWW-Authenticate",
+					`Bearer realm="`+registry()+`/token",service="registry",scope="repository:org/manifests:pull"`)
+				w.WriteHeader(http.StatusUnauthorized)
+
+				return
+			}
+			switch r.URL.Path {
+			case "/v2/org/manifests/manifests/v1":
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{"layers": layers})
+			case "/v2/org/manifests/blobs/sha256:" + digest:
+				_, _ = w.Write(archive)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		})
+
+		return mux
+	}
+
+	newTLSRegistry := func() *httptest.Server {
+		var server *httptest.Server
+		server = httptest.NewTLSServer(registryHandler(func() string { return server.URL }))
+		DeferCleanup(server.Close)
+
+		return server
+	}
+
+	newPlainRegistry := func() *httptest.Server {
+		var server *httptest.Server
+		server = httptest.NewServer(registryHandler(func() string { return server.URL }))
+		DeferCleanup(server.Close)
+
+		return server
+	}
+
+	dsciTrusting := func(server *httptest.Server) *dsciv1.DSCInitialization {
+		dsci := &dsciv1.DSCInitialization{ObjectMeta: metav1.ObjectMeta{Name: "default-dsci"}}
+		if server != nil {
+			dsci.Spec.TrustedCABundle = &dsciv1.TrustedCABundleSpec{
+				ManagementState: operatorv1.Managed,
+				CustomCABundle:  string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})),
+			}
+		}
+
+		return dsci
+	}
+
+	imageConfig := func(insecureRegistries ...interface{}) *unstructured.Unstructured {
+		config := &unstructured.Unstructured{}
+		config.SetGroupVersionKind(gvk.OpenshiftImageConfig)
+		config.SetName("cluster")
+		Expect(unstructured.SetNestedSlice(config.Object, insecureRegistries, "spec", "registrySources", "insecureRegistries")).To(Succeed())
+
+		return config
+	}
+
+	fetch := func(server *httptest.Server, cli client.Client, sha256sum string) error {
+		return deploy.FetchManifests(ctx, cli, target, components.ManifestsConfig{
+			URI:        "oci://" + server.Listener.Addr().String() + "/org/manifests:v1",
+			ContextDir: "manifests",
+			SHA256:     sha256sum,
+		})
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme = runtime.NewScheme()
+		utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+		utilruntime.Must(dsciv1.AddToScheme(scheme))
+		target = GinkgoT().TempDir()
+
+		archive = archiveOf(archiveEntry{name: "repo/manifests/base/kustomization.yaml", content: []byte("resources: []")})
+		sum := sha256.Sum256(archive)
+		digest = hex.EncodeToString(sum[:])
+		layers = []map[string]string{
+			{"mediaType": "application/vnd.oci.image.config.v1+json", "digest": "sha256:0000"},
+			{"mediaType": "application/vnd.oci.image.layer.v1.tar+gzip", "digest": "sha256:" + digest},
+		}
+	})
+
+	It("should authenticate with a token and trust the CA bundle of the DSCInitialization", func() {
+		server := newTLSRegistry()
+		cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(dsciTrusting(server)).Build()
+
+		Expect(fetch(server, cli, digest)).To(Succeed())
+
+		Expect(filepath.Join(target, "base", "kustomization.yaml")).To(BeARegularFile())
+	})
+
+	It("should not trust a registry whose CA is not in the bundle", func() {
+		server := newTLSRegistry()
+		cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(dsciTrusting(nil)).Build()
+
+		Expect(fetch(server, cli, "")).To(MatchError(ContainSubstring("certificate")))
+	})
+
+	It("should reject an artifact which does not match the expected checksum", func() {
+		server := newTLSRegistry()
+		cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(dsciTrusting(server)).Build()
+
+		Expect(fetch(server, cli, strings.Repeat("0", 64))).To(MatchError(ContainSubstring("checksum mismatch")))
+	})
+
+	It("should reject an artifact without tar+gzip layer", func() {
+		server := newTLSRegistry()
+		cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(dsciTrusting(server)).Build()
+		layers = layers[:1]
+
+		Expect(fetch(server, cli, "")).To(MatchError(ContainSubstring("has no tar+gzip layer")))
+	})
+
+	It("should not verify the certificate of an insecure registry", func() {
+		server := newTLSRegistry()
+		cli := fake.NewClientBuilder().WithScheme(scheme).
+			WithObjects(dsciTrusting(nil), imageConfig(server.Listener.Addr().String())).
+			Build()
+
+		Expect(fetch(server, cli, "")).To(Succeed())
+	})
+
+	It("should pull over http from an insecure registry which does not serve https", func() {
+		server := newPlainRegistry()
+		cli := fake.NewClientBuilder().WithScheme(scheme).
+			WithObjects(dsciTrusting(nil), imageConfig("registry.example.com", server.Listener.Addr().String())).
+			Build()
+
+		Expect(fetch(server, cli, "")).To(Succeed())
+		Expect(filepath.Join(target, "base", "kustomization.yaml")).To(BeARegularFile())
+	})
+
+	It("should only pull over https from registries which are not insecure", func() {
+		server := newPlainRegistry()
+		cli := fake.NewClientBuilder().WithScheme(scheme).
+			WithObjects(dsciTrusting(nil), imageConfig("*.example.com")).
+			Build()
+
+		Expect(fetch(server, cli, "")).To(MatchError(ContainSubstring("HTTP response to HTTPS client")))
+	})
+
+	DescribeTable("should split the parameters of authentication challenges",
+		func(params string, expected []string) {
+			Expect(deploy.SplitChallengeParams(params)).To(Equal(expected))
+		},
+		Entry("single parameter", `realm="https://auth.example.com/token"`, []string{`realm="https://auth.example.com/token"`}),
+		Entry("several parameters", `realm="https://auth.example.com/token",service="registry"`,
+			[]string{`realm="https://auth.example.com/token"`, `service="registry"`}),
+		Entry("commas in quoted values", `service="registry", scope="repository:org/repo:pull,push"`,
+			[]string{`service="registry"`, `scope="repository:org/repo:pull,push"`}),
+	)
+
+	Context("reading credentials", func() {
+
+		const namespace = "opendatahub-operator-system"
+
+		pullSecret := func(name, namespace string, auths map[string]map[string]string) *corev1.Secret {
+			config, err := json.Marshal(map[string]interface{}{"auths": auths})
+			Expect(err).ToNot(HaveOccurred())
+
+			return &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+				Type:       corev1.SecretTypeDockerConfigJson,
+				Data:       map[string][]byte{corev1.DockerConfigJsonKey: config},
+			}
+		}
+		credentials := func(registry string, objs ...client.Object) (string, string, error) {
+			cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+
+			return deploy.RegistryCredentials(ctx, cli, namespace, registry)
+		}
+
+		It("should decode the auth of the registry", func() {
+			auth := base64.StdEncoding.EncodeToString([]byte("robot:p@ss:word"))
+
+			username, password, err := credentials("quay.io", pullSecret("pull-secret", namespace, map[string]map[string]string{
+				"registry.example.com": {"auth": base64.StdEncoding.EncodeToString([]byte("other:secret"))},
+				"quay.io":              {"auth": auth},
+			}))
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(username).To(Equal("robot"))
+			Expect(password).To(Equal("p@ss:word"))
+		})
+
+		It("should read the username and password of servers given as URLs", func() {
+			username, password, err := credentials("index.docker.io", pullSecret("pull-secret", namespace, map[string]map[string]string{
+				"https://index.docker.io/v1/": {"username": "user", "password": "secret"},
+			}))
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(username).To(Equal("user"))
+			Expect(password).To(Equal("secret"))
+		})
+
+		It("should ignore secrets of other namespaces and types", func() {
+			opaque := pullSecret("opaque", namespace, map[string]map[string]string{"quay.io": {"username": "user", "password": "secret"}})
+			opaque.Type = corev1.SecretTypeOpaque
+
+			username, password, err := credentials("quay.io",
+				opaque,
+				pullSecret("pull-secret", "other", map[string]map[string]string{"quay.io": {"username": "user", "password": "secret"}}),
+			)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(username).To(BeEmpty())
+			Expect(password).To(BeEmpty())
+		})
+
+		It("should fail on invalid auth", func() {
+			_, _, err := credentials("quay.io", pullSecret("pull-secret", namespace, map[string]map[string]string{
+				"quay.io": {"auth": "not base64!"},
+			}))
+
+			Expect(err).To(MatchError(ContainSubstring("invalid credentials of quay.io in secret pull-secret")))
+		})
+	})
+})
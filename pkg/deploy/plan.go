@@ -0,0 +1,157 @@
+package deploy
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+)
+
+// PlanAction is the kind of change the operator would perform on an object.
+type PlanAction string
+
+const (
+	PlanActionCreate PlanAction = "Create"
+	PlanActionUpdate PlanAction = "Update"
+	PlanActionDelete PlanAction = "Delete"
+	// PlanActionWait is informational, reporting a step which would wait for the cluster before going on.
+	PlanActionWait PlanAction = "Wait"
+)
+
+// PlannedChange describes a single change the operator would perform when reconciling a component.
+type PlannedChange struct {
+	Action    PlanAction
+	Component string
+	Object    corev1.ObjectReference
+	// Fields lists paths of the fields which would be changed by an update.
+	Fields []string
+	// Message gives additional details about the change, if any.
+	Message string
+}
+
+type planKey struct{}
+
+// Plan collects changes computed in plan mode. When a Plan is attached to the context using WithPlan,
+// DeployManifestsFromPath sends all its requests to the API server as dry-run and records what would
+// be created, updated and deleted instead of changing the cluster.
+type Plan struct {
+	mu      sync.Mutex
+	changes []PlannedChange
+}
+
+func NewPlan() *Plan {
+	return &Plan{}
+}
+
+// WithPlan returns a context which turns on plan mode, recording changes in the given plan.
+func WithPlan(ctx context.Context, plan *Plan) context.Context {
+	return context.WithValue(ctx, planKey{}, plan)
+}
+
+// PlanFrom returns the plan attached to the context, nil when not running in plan mode.
+func PlanFrom(ctx context.Context) *Plan {
+	plan, _ := ctx.Value(planKey{}).(*Plan)
+
+	return plan
+}
+
+// Record adds a change to the plan.
+func (p *Plan) Record(change PlannedChange) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.changes = append(p.changes, change)
+}
+
+// RecordNotReady adds an informational change to the plan when err only reports steps of the component waiting for the
+// cluster, e.g. workloads of a dependency becoming ready, which are expected while computing a plan. It returns false for
+// other errors, which are not recorded.
+func (p *Plan) RecordNotReady(componentName string, err error) bool {
+	notReady, waiting := cluster.AsNotReady(err)
+	if !waiting {
+		return false
+	}
+
+	p.Record(PlannedChange{
+		Action:    PlanActionWait,
+		Component: componentName,
+		Message:   notReady.Message,
+	})
+
+	return true
+}
+
+// Changes returns all recorded changes, in the order they have been computed.
+func (p *Plan) Changes() []PlannedChange {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return append([]PlannedChange(nil), p.changes...)
+}
+
+func (p *Plan) recordObject(action PlanAction, componentName string, obj *unstructured.Unstructured, fields []string) {
+	p.Record(PlannedChange{
+		Action:    action,
+		Component: componentName,
+		Object: corev1.ObjectReference{
+			APIVersion: obj.GetAPIVersion(),
+			Kind:       obj.GetKind(),
+			Namespace:  obj.GetNamespace(),
+			Name:       obj.GetName(),
+		},
+		Fields: fields,
+	})
+}
+
+// changedFields compares the live object with the result of a dry-run update and returns paths of the fields which differ.
+// Fields maintained by the API server are ignored.
+func changedFields(live, updated *unstructured.Unstructured) []string {
+	ignored := map[string]bool{
+		"metadata.managedFields":     true,
+		"metadata.resourceVersion":   true,
+		"metadata.generation":        true,
+		"metadata.creationTimestamp": true,
+		"metadata.uid":               true,
+		"status":                     true,
+	}
+
+	var fields []string
+	diffFields(nil, live.Object, updated.Object, ignored, &fields)
+	sort.Strings(fields)
+
+	return fields
+}
+
+func diffFields(path []string, before, after interface{}, ignored map[string]bool, fields *[]string) {
+	joined := strings.Join(path, ".")
+	if ignored[joined] {
+		return
+	}
+
+	beforeMap, beforeIsMap := before.(map[string]interface{})
+	afterMap, afterIsMap := after.(map[string]interface{})
+	if !beforeIsMap || !afterIsMap {
+		if !reflect.DeepEqual(before, after) {
+			*fields = append(*fields, joined)
+		}
+
+		return
+	}
+
+	keys := make(map[string]struct{}, len(beforeMap)+len(afterMap))
+	for key := range beforeMap {
+		keys[key] = struct{}{}
+	}
+	for key := range afterMap {
+		keys[key] = struct{}{}
+	}
+	for key := range keys {
+		diffFields(append(append([]string(nil), path...), key), beforeMap[key], afterMap[key], ignored, fields)
+	}
+}
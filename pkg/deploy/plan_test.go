@@ -0,0 +1,127 @@
+package deploy_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Planning changes", func() {
+
+	Context("comparing a live object with the result of a dry-run update", func() {
+
+		configMap := func(fields map[string]interface{}) *unstructured.Unstructured {
+			obj := map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata": map[string]interface{}{
+					"name":      "config",
+					"namespace": "opendatahub",
+				},
+			}
+			for key, value := range fields {
+				obj[key] = value
+			}
+
+			return &unstructured.Unstructured{Object: obj}
+		}
+
+		It("should not report any field for identical objects", func() {
+			live := configMap(map[string]interface{}{"data": map[string]interface{}{"key": "value"}})
+
+			Expect(deploy.ChangedFields(live, live.DeepCopy())).To(BeEmpty())
+		})
+
+		It("should report the paths of changed, added and removed fields in order", func() {
+			live := configMap(map[string]interface{}{
+				"data": map[string]interface{}{"changed": "before", "removed": "value", "kept": "value"},
+			})
+			updated := configMap(map[string]interface{}{
+				"data": map[string]interface{}{"changed": "after", "added": "value", "kept": "value"},
+			})
+
+			Expect(deploy.ChangedFields(live, updated)).To(Equal([]string{"data.added", "data.changed", "data.removed"}))
+		})
+
+		It("should report a list as a whole", func() {
+			live := configMap(map[string]interface{}{
+				"spec": map[string]interface{}{"items": []interface{}{"a", "b"}},
+			})
+			updated := configMap(map[string]interface{}{
+				"spec": map[string]interface{}{"items": []interface{}{"a", "c"}},
+			})
+
+			Expect(deploy.ChangedFields(live, updated)).To(Equal([]string{"spec.items"}))
+		})
+
+		It("should report a field whose type changed", func() {
+			live := configMap(map[string]interface{}{"data": map[string]interface{}{"key": "value"}})
+			updated := configMap(map[string]interface{}{"data": "value"})
+
+			Expect(deploy.ChangedFields(live, updated)).To(Equal([]string{"data"}))
+		})
+
+		It("should ignore fields maintained by the API server and the status", func() {
+			live := configMap(map[string]interface{}{"status": map[string]interface{}{"ready": false}})
+			live.SetResourceVersion("1")
+			live.SetGeneration(1)
+			live.SetUID("before")
+			updated := configMap(map[string]interface{}{"status": map[string]interface{}{"ready": true}})
+			updated.SetResourceVersion("2")
+			updated.SetGeneration(2)
+			updated.SetUID("after")
+			updated.SetManagedFields(nil)
+			updated.SetLabels(map[string]string{"app": "odh"})
+
+			Expect(deploy.ChangedFields(live, updated)).To(Equal([]string{"metadata.labels"}))
+		})
+	})
+
+	Context("recording errors of steps waiting for the cluster", func() {
+
+		It("should record an informational change for the component", func() {
+			plan := deploy.NewPlan()
+			err := fmt.Errorf("failed applying features: %w", cluster.NewNotReadyError("1/2 pods are ready in namespace %s", "knative-serving"))
+
+			Expect(plan.RecordNotReady("kserve", err)).To(BeTrue())
+			Expect(plan.Changes()).To(ConsistOf(deploy.PlannedChange{
+				Action:    deploy.PlanActionWait,
+				Component: "kserve",
+				Message:   "1/2 pods are ready in namespace knative-serving",
+			}))
+		})
+
+		It("should not record other errors", func() {
+			plan := deploy.NewPlan()
+			err := multierror.Append(cluster.NewNotReadyError("waiting for pods"), errors.New("failed applying manifests"))
+
+			Expect(plan.RecordNotReady("kserve", err)).To(BeFalse())
+			Expect(plan.Changes()).To(BeEmpty())
+		})
+	})
+
+	It("should not use the manifests cache attached to the context", func() {
+		cache := deploy.NewManifestsCache(GinkgoT().TempDir(), time.Hour)
+		ctx := deploy.WithManifestsCache(context.Background(), cache)
+
+		attached, cleanup, err := deploy.ManifestsCacheFrom(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		cleanup()
+		Expect(attached).To(BeIdenticalTo(cache))
+
+		planned, cleanup, err := deploy.ManifestsCacheFrom(deploy.WithPlan(ctx, deploy.NewPlan()))
+		Expect(err).ToNot(HaveOccurred())
+		defer cleanup()
+		Expect(planned).ToNot(BeIdenticalTo(cache))
+	})
+})
@@ -0,0 +1,145 @@
+package deploy
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
+)
+
+var preservedPathPattern = regexp.MustCompile(`^(\.[A-Za-z0-9_-]+(\[\*\])?)+$`)
+
+type preservedFieldsKey struct{}
+
+// WithPreservedFields returns a context which makes DeployManifestsFromPath keep the fields of existing objects listed
+// in the given policies, e.g. the ones of DSCInitialization and the ones of the reconciled component.
+func WithPreservedFields(ctx context.Context, policies ...[]infrav1.FieldPreservationPolicy) context.Context {
+	var all []infrav1.FieldPreservationPolicy
+	for _, p := range policies {
+		all = append(all, p...)
+	}
+
+	return context.WithValue(ctx, preservedFieldsKey{}, all)
+}
+
+func preservedFieldsFrom(ctx context.Context) []infrav1.FieldPreservationPolicy {
+	policies, _ := ctx.Value(preservedFieldsKey{}).([]infrav1.FieldPreservationPolicy)
+
+	return policies
+}
+
+// pathSegment is a field of a preserved path. Items of the field are traversed when it is a list marked with [*].
+type pathSegment struct {
+	field string
+	items bool
+}
+
+// ValidatePreservedPath checks the path of a preserved field is supported, without matching it against any object.
+func ValidatePreservedPath(path string) error {
+	_, err := parsePreservedPath(path)
+
+	return err
+}
+
+func parsePreservedPath(path string) ([]pathSegment, error) {
+	if !preservedPathPattern.MatchString(path) {
+		return nil, fmt.Errorf("invalid preserved field path %s, expected fields such as .spec.replicas, lists being marked with [*]", path)
+	}
+
+	fields := strings.Split(strings.TrimPrefix(path, "."), ".")
+	segments := make([]pathSegment, 0, len(fields))
+	for _, field := range fields {
+		segments = append(segments, pathSegment{field: strings.TrimSuffix(field, "[*]"), items: strings.HasSuffix(field, "[*]")})
+	}
+
+	return segments, nil
+}
+
+// preserveFields sets the fields listed in the policies matching the object to their values in the existing object, so applying
+// it does not overwrite them. Fields the existing object does not have are removed. Fields not set in obj are left out,
+// so the operator does not take ownership of fields it does not deploy.
+func preserveFields(obj, found *unstructured.Unstructured, policies []infrav1.FieldPreservationPolicy) error {
+	gvk := obj.GroupVersionKind()
+	for _, policy := range policies {
+		if policy.Group != gvk.Group || policy.Kind != gvk.Kind || (policy.Version != "" && policy.Version != gvk.Version) {
+			continue
+		}
+		for _, path := range policy.Paths {
+			segments, err := parsePreservedPath(path)
+			if err != nil {
+				return err
+			}
+			preserveField(obj.Object, found.Object, segments)
+		}
+	}
+
+	return nil
+}
+
+func preserveField(desired, live map[string]interface{}, segments []pathSegment) {
+	segment := segments[0]
+	value, exists := desired[segment.field]
+	if !exists {
+		return
+	}
+
+	if len(segments) == 1 {
+		if liveValue, found := live[segment.field]; found {
+			desired[segment.field] = liveValue
+		} else {
+			delete(desired, segment.field)
+		}
+
+		return
+	}
+
+	if !segment.items {
+		fields, ok := value.(map[string]interface{})
+		if !ok {
+			return
+		}
+		liveFields, _ := live[segment.field].(map[string]interface{})
+		preserveField(fields, liveFields, segments[1:])
+
+		return
+	}
+
+	items, ok := value.([]interface{})
+	if !ok {
+		return
+	}
+	liveItems, _ := live[segment.field].([]interface{})
+	for i := range items {
+		fields, ok := items[i].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		preserveField(fields, matchingItem(liveItems, fields, i), segments[1:])
+	}
+}
+
+// matchingItem returns the item of the live list corresponding to the desired one, by name or by position.
+func matchingItem(liveItems []interface{}, desired map[string]interface{}, index int) map[string]interface{} {
+	name, named := desired["name"]
+	if !named {
+		if index < len(liveItems) {
+			item, _ := liveItems[index].(map[string]interface{})
+
+			return item
+		}
+
+		return nil
+	}
+
+	for _, liveItem := range liveItems {
+		if item, ok := liveItem.(map[string]interface{}); ok && item["name"] == name {
+			return item
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package deploy_test
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Preserving fields of existing objects", func() {
+
+	DescribeTable("should validate preserved paths",
+		func(path string, valid bool) {
+			err := deploy.ValidatePreservedPath(path)
+			if valid {
+				Expect(err).ToNot(HaveOccurred())
+			} else {
+				Expect(err).To(MatchError(ContainSubstring("invalid preserved field path " + path)))
+			}
+		},
+		Entry("field", ".spec.replicas", true),
+		Entry("fields of list items", ".spec.template.spec.containers[*].resources", true),
+		Entry("nested lists", ".spec.template.spec.containers[*].ports[*].containerPort", true),
+		Entry("missing leading dot", "spec.replicas", false),
+		Entry("empty path", "", false),
+		Entry("list index", ".spec.template.spec.containers[0].resources", false),
+		Entry("list selector", `.spec.template.spec.containers[name="manager"].resources`, false),
+		Entry("trailing dot", ".spec.", false),
+	)
+
+	Context("applying policies", func() {
+
+		var desired, live *unstructured.Unstructured
+
+		deployment := func(replicas int64, containers ...interface{}) *unstructured.Unstructured {
+			return &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata":   map[string]interface{}{"name": "dashboard", "namespace": "opendatahub"},
+				"spec": map[string]interface{}{
+					"replicas": replicas,
+					"template": map[string]interface{}{
+						"spec": map[string]interface{}{"containers": containers},
+					},
+				},
+			}}
+		}
+		container := func(name, cpu string) map[string]interface{} {
+			item := map[string]interface{}{"name": name, "image": name + ":desired"}
+			if cpu != "" {
+				item["resources"] = map[string]interface{}{"limits": map[string]interface{}{"cpu": cpu}}
+			}
+
+			return item
+		}
+		containers := func(obj *unstructured.Unstructured) []interface{} {
+			items, _, err := unstructured.NestedSlice(obj.Object, "spec", "template", "spec", "containers")
+			Expect(err).ToNot(HaveOccurred())
+
+			return items
+		}
+		policy := func(paths ...string) []infrav1.FieldPreservationPolicy {
+			return []infrav1.FieldPreservationPolicy{{Group: "apps", Kind: "Deployment", Paths: paths}}
+		}
+
+		BeforeEach(func() {
+			desired = deployment(1, container("dashboard", "500m"), container("oauth-proxy", "100m"))
+			live = deployment(3, container("oauth-proxy", "200m"), container("dashboard", "2"))
+		})
+
+		It("should keep the live value of a field", func() {
+			Expect(deploy.PreserveFields(desired, live, policy(".spec.replicas"))).To(Succeed())
+
+			Expect(desired.Object["spec"]).To(HaveKeyWithValue("replicas", int64(3)))
+		})
+
+		It("should match list items by name", func() {
+			Expect(deploy.PreserveFields(desired, live, policy(".spec.template.spec.containers[*].resources"))).To(Succeed())
+
+			Expect(containers(desired)).To(Equal([]interface{}{
+				map[string]interface{}{"name": "dashboard", "image": "dashboard:desired", "resources": map[string]interface{}{"limits": map[string]interface{}{"cpu": "2"}}},
+				map[string]interface{}{"name": "oauth-proxy", "image": "oauth-proxy:desired", "resources": map[string]interface{}{"limits": map[string]interface{}{"cpu": "200m"}}},
+			}))
+		})
+
+		It("should match list items without name by position", func() {
+			desired = deployment(1, map[string]interface{}{"image": "first", "args": []interface{}{"--desired"}})
+			live = deployment(1, map[string]interface{}{"image": "first", "args": []interface{}{"--live"}})
+
+			Expect(deploy.PreserveFields(desired, live, policy(".spec.template.spec.containers[*].args"))).To(Succeed())
+
+			Expect(containers(desired)).To(ConsistOf(HaveKeyWithValue("args", []interface{}{"--live"})))
+		})
+
+		It("should remove a field the live object does not have", func() {
+			live = deployment(3, container("dashboard", ""), container("oauth-proxy", "200m"))
+
+			Expect(deploy.PreserveFields(desired, live, policy(".spec.template.spec.containers[*].resources"))).To(Succeed())
+
+			Expect(containers(desired)[0]).ToNot(HaveKey("resources"))
+			Expect(containers(desired)[1]).To(HaveKey("resources"))
+		})
+
+		It("should not add a field which is not deployed", func() {
+			desired = deployment(1, container("dashboard", ""))
+			live = deployment(3, container("dashboard", "2"))
+
+			Expect(deploy.PreserveFields(desired, live, policy(".spec.template.spec.containers[*].resources"))).To(Succeed())
+
+			Expect(containers(desired)[0]).ToNot(HaveKey("resources"))
+		})
+
+		It("should only apply policies matching the group, version and kind of the object", func() {
+			policies := []infrav1.FieldPreservationPolicy{
+				{Group: "", Kind: "Deployment", Paths: []string{".spec.replicas"}},
+				{Group: "apps", Version: "v1beta1", Kind: "Deployment", Paths: []string{".spec.replicas"}},
+				{Group: "apps", Kind: "StatefulSet", Paths: []string{".spec.replicas"}},
+			}
+
+			Expect(deploy.PreserveFields(desired, live, policies)).To(Succeed())
+			Expect(desired.Object["spec"]).To(HaveKeyWithValue("replicas", int64(1)))
+
+			Expect(deploy.PreserveFields(desired, live, []infrav1.FieldPreservationPolicy{
+				{Group: "apps", Version: "v1", Kind: "Deployment", Paths: []string{".spec.replicas"}},
+			})).To(Succeed())
+			Expect(desired.Object["spec"]).To(HaveKeyWithValue("replicas", int64(3)))
+		})
+
+		It("should fail on an invalid path", func() {
+			Expect(deploy.PreserveFields(desired, live, policy("spec.replicas"))).
+				To(MatchError(ContainSubstring("invalid preserved field path spec.replicas")))
+		})
+	})
+})
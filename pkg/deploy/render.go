@@ -0,0 +1,128 @@
+package deploy
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type rendererKey struct{}
+
+// Renderer collects objects exactly as they would be applied to the cluster, so they can be inspected without a cluster.
+// When a Renderer is attached to the context using WithRenderer, DeployManifestsFromPath records each object after
+// the namespace and labels transformations have been applied, and Features render their manifests instead of applying them.
+type Renderer struct {
+	mu      sync.Mutex
+	client  client.Client
+	objects []*unstructured.Unstructured
+	patches []*unstructured.Unstructured
+}
+
+// NewRenderer creates a Renderer reading the state rendering depends on, such as the cluster domain used
+// by Feature templates, through the given client. It is usually a fake client seeded with the required objects.
+func NewRenderer(cli client.Client) *Renderer {
+	return &Renderer{client: cli}
+}
+
+// WithRenderer returns a context which turns on render mode, recording objects in the given renderer.
+func WithRenderer(ctx context.Context, renderer *Renderer) context.Context {
+	return context.WithValue(ctx, rendererKey{}, renderer)
+}
+
+// RendererFrom returns the renderer attached to the context, nil when not running in render mode.
+func RendererFrom(ctx context.Context) *Renderer {
+	renderer, _ := ctx.Value(rendererKey{}).(*Renderer)
+
+	return renderer
+}
+
+// Client returns the client used to read the state rendering depends on.
+func (r *Renderer) Client() client.Client {
+	return r.client
+}
+
+// Record adds copies of the given objects to the rendered ones. An object rendered more than once, e.g. when it is shared
+// between components, replaces the previous one while retaining its component labels, the same way updateResource does.
+func (r *Renderer) Record(objs ...*unstructured.Unstructured) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, obj := range objs {
+		rendered := obj.DeepCopy()
+		if i := indexOf(r.objects, rendered); i >= 0 {
+			updateLabels(r.objects[i], rendered)
+			r.objects[i] = rendered
+
+			continue
+		}
+		r.objects = append(r.objects, rendered)
+	}
+}
+
+// Patch applies the given merge patches to the objects rendered so far. Patches targeting objects which have not
+// been rendered, as they are created outside of the operator, are kept aside and returned by Patches.
+func (r *Renderer) Patch(patches ...*unstructured.Unstructured) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, patch := range patches {
+		i := indexOf(r.objects, patch)
+		if i < 0 {
+			r.patches = append(r.patches, patch.DeepCopy())
+
+			continue
+		}
+
+		original, err := r.objects[i].MarshalJSON()
+		if err != nil {
+			return err
+		}
+		patchAsJSON, err := patch.MarshalJSON()
+		if err != nil {
+			return fmt.Errorf("error converting patch to json: %w", err)
+		}
+		patched, err := jsonpatch.MergePatch(original, patchAsJSON)
+		if err != nil {
+			return fmt.Errorf("failed patching rendered %s %s: %w", patch.GetKind(), patch.GetName(), err)
+		}
+
+		patchedObj := &unstructured.Unstructured{}
+		if err := patchedObj.UnmarshalJSON(patched); err != nil {
+			return err
+		}
+		r.objects[i] = patchedObj
+	}
+
+	return nil
+}
+
+// Objects returns all rendered objects, in the order they have been rendered first.
+func (r *Renderer) Objects() []*unstructured.Unstructured {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]*unstructured.Unstructured(nil), r.objects...)
+}
+
+// Patches returns the patches which could not be applied to any of the rendered objects.
+func (r *Renderer) Patches() []*unstructured.Unstructured {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]*unstructured.Unstructured(nil), r.patches...)
+}
+
+func indexOf(objs []*unstructured.Unstructured, obj *unstructured.Unstructured) int {
+	for i := range objs {
+		if objs[i].GroupVersionKind().GroupKind() == obj.GroupVersionKind().GroupKind() &&
+			objs[i].GetNamespace() == obj.GetNamespace() && objs[i].GetName() == obj.GetName() {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,119 @@
+package deploy_test
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Rendering objects", func() {
+
+	var renderer *deploy.Renderer
+
+	object := func(kind, name string, fields map[string]interface{}) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{Object: map[string]interface{}{}}
+		for key, value := range fields {
+			obj.Object[key] = value
+		}
+		obj.SetAPIVersion("v1")
+		obj.SetKind(kind)
+		obj.SetName(name)
+		obj.SetNamespace("opendatahub")
+
+		return obj
+	}
+
+	BeforeEach(func() {
+		renderer = deploy.NewRenderer(fake.NewClientBuilder().Build())
+	})
+
+	It("should be attached to the context", func() {
+		Expect(deploy.RendererFrom(context.Background())).To(BeNil())
+		Expect(deploy.RendererFrom(deploy.WithRenderer(context.Background(), renderer))).To(BeIdenticalTo(renderer))
+	})
+
+	Context("recording objects", func() {
+
+		It("should keep objects in the order they are rendered first", func() {
+			renderer.Record(object("ConfigMap", "first", nil), object("Secret", "second", nil))
+			renderer.Record(object("ConfigMap", "third", nil))
+
+			names := []string{}
+			for _, obj := range renderer.Objects() {
+				names = append(names, obj.GetName())
+			}
+			Expect(names).To(Equal([]string{"first", "second", "third"}))
+		})
+
+		It("should record a copy of the objects", func() {
+			obj := object("ConfigMap", "config", map[string]interface{}{"data": map[string]interface{}{"key": "value"}})
+			renderer.Record(obj)
+
+			Expect(unstructured.SetNestedField(obj.Object, "changed", "data", "key")).To(Succeed())
+
+			Expect(renderer.Objects()[0].Object).To(HaveKeyWithValue("data", map[string]interface{}{"key": "value"}))
+		})
+
+		It("should replace an object rendered again while retaining its component labels", func() {
+			first := object("ConfigMap", "shared", map[string]interface{}{"data": map[string]interface{}{"key": "first"}})
+			first.SetLabels(map[string]string{"app.opendatahub.io/kserve": "true", "other": "first"})
+			second := object("ConfigMap", "shared", map[string]interface{}{"data": map[string]interface{}{"key": "second"}})
+			second.SetLabels(map[string]string{"app.opendatahub.io/model-mesh": "true"})
+
+			renderer.Record(first, object("Secret", "shared", nil))
+			renderer.Record(second)
+
+			objects := renderer.Objects()
+			Expect(objects).To(HaveLen(2))
+			Expect(objects[0].Object).To(HaveKeyWithValue("data", map[string]interface{}{"key": "second"}))
+			Expect(objects[0].GetLabels()).To(Equal(map[string]string{
+				"app.opendatahub.io/kserve":     "true",
+				"app.opendatahub.io/model-mesh": "true",
+			}))
+			Expect(objects[1].GetKind()).To(Equal("Secret"))
+		})
+	})
+
+	Context("patching objects", func() {
+
+		It("should merge the patch into the rendered object", func() {
+			renderer.Record(object("ConfigMap", "config", map[string]interface{}{
+				"data": map[string]interface{}{"kept": "value", "changed": "before", "removed": "value"},
+			}))
+
+			Expect(renderer.Patch(object("ConfigMap", "config", map[string]interface{}{
+				"data": map[string]interface{}{"changed": "after", "removed": nil},
+			}))).To(Succeed())
+
+			Expect(renderer.Objects()[0].Object).To(HaveKeyWithValue("data", map[string]interface{}{"kept": "value", "changed": "after"}))
+			Expect(renderer.Patches()).To(BeEmpty())
+		})
+
+		It("should keep patches targeting objects which have not been rendered aside", func() {
+			renderer.Record(object("ConfigMap", "config", nil))
+			patch := object("ConfigMap", "external", map[string]interface{}{"data": map[string]interface{}{"key": "value"}})
+
+			Expect(renderer.Patch(patch)).To(Succeed())
+
+			Expect(renderer.Objects()).To(HaveLen(1))
+			Expect(renderer.Patches()).To(HaveLen(1))
+			Expect(renderer.Patches()[0].Object).To(Equal(patch.Object))
+		})
+
+		It("should match patches by kind, namespace and name", func() {
+			renderer.Record(object("ConfigMap", "config", nil))
+			patch := object("Secret", "config", map[string]interface{}{"data": map[string]interface{}{"key": "dmFsdWU="}})
+
+			Expect(renderer.Patch(patch)).To(Succeed())
+
+			Expect(renderer.Objects()[0].Object).ToNot(HaveKey("data"))
+			Expect(renderer.Patches()).To(HaveLen(1))
+		})
+	})
+})
@@ -0,0 +1,170 @@
+package deploy
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// Cluster-scoped objects deployed for several owners of the same kind, e.g. several DataScienceClusters enabling the
+// same component, are shared: each owner is referenced by the object, which is only removed once the last of them
+// disables the component. Deleted owners are released by the garbage collector the same way.
+
+// sharedWithOwner tells whether the existing object is cluster-scoped and used by another owner of the kind of owner.
+func sharedWithOwner(found *unstructured.Unstructured, owner metav1.Object, scheme *runtime.Scheme) (bool, error) {
+	if found.GetNamespace() != "" {
+		return false, nil
+	}
+	ownerGVK, err := gvkOf(owner, scheme)
+	if err != nil {
+		return false, err
+	}
+	for _, ref := range found.GetOwnerReferences() {
+		if ref.UID != owner.GetUID() && isOfKind(ref, ownerGVK) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// setSharedOwner keeps the owners of the existing object, adding owner to them. The controller of the object is unchanged.
+func setSharedOwner(obj, found *unstructured.Unstructured, owner metav1.Object, scheme *runtime.Scheme) error {
+	refs := found.GetOwnerReferences()
+	obj.SetOwnerReferences(refs)
+	for _, ref := range refs {
+		if ref.UID == owner.GetUID() {
+			return nil
+		}
+	}
+
+	return controllerutil.SetOwnerReference(owner, obj, scheme)
+}
+
+// releaseSharedResource removes owner from the owners of a cluster-scoped object still used by other owners of its
+// kind, instead of deleting the object. Another owner becomes the controller if owner was. It returns false when the
+// object is not used by other owners, and can be deleted.
+func releaseSharedResource(ctx context.Context, cli client.Client, found *unstructured.Unstructured, owner metav1.Object,
+	applicationNamespace, componentName string,
+) (bool, error) {
+	shared, err := sharedWithOwner(found, owner, cli.Scheme())
+	if err != nil || !shared {
+		return false, err
+	}
+	ownerGVK, err := gvkOf(owner, cli.Scheme())
+	if err != nil {
+		return false, err
+	}
+
+	var refs []metav1.OwnerReference
+	released := false
+	for _, ref := range found.GetOwnerReferences() {
+		if ref.UID == owner.GetUID() {
+			released = released || (ref.Controller != nil && *ref.Controller)
+
+			continue
+		}
+		refs = append(refs, ref)
+	}
+	if len(refs) == len(found.GetOwnerReferences()) {
+		// the owner never deployed the object
+		return true, nil
+	}
+	if released {
+		for i := range refs {
+			if isOfKind(refs[i], ownerGVK) {
+				refs[i].Controller = &released
+
+				break
+			}
+		}
+	}
+
+	patch := client.MergeFrom(found.DeepCopy())
+	found.SetOwnerReferences(refs)
+	if err := removeSubjects(found, applicationNamespace); err != nil {
+		return true, err
+	}
+	if plan := PlanFrom(ctx); plan != nil {
+		plan.recordObject(PlanActionUpdate, componentName, found, []string{".metadata.ownerReferences"})
+	}
+
+	return true, cli.Patch(ctx, found, patch)
+}
+
+// mergeSubjects keeps the subjects of a shared ClusterRoleBinding, which are bound to the namespace of each owner.
+func mergeSubjects(obj, found *unstructured.Unstructured) error {
+	if obj.GetKind() != "ClusterRoleBinding" {
+		return nil
+	}
+	subjects, _, err := unstructured.NestedSlice(obj.Object, "subjects")
+	if err != nil {
+		return err
+	}
+	foundSubjects, _, err := unstructured.NestedSlice(found.Object, "subjects")
+	if err != nil {
+		return err
+	}
+
+	seen := map[string]bool{}
+	for _, s := range subjects {
+		seen[subjectKey(s)] = true
+	}
+	for _, s := range foundSubjects {
+		if !seen[subjectKey(s)] {
+			subjects = append(subjects, s)
+		}
+	}
+
+	return unstructured.SetNestedSlice(obj.Object, subjects, "subjects")
+}
+
+// removeSubjects removes the service accounts of the given namespace from the subjects of a ClusterRoleBinding.
+func removeSubjects(obj *unstructured.Unstructured, namespace string) error {
+	if obj.GetKind() != "ClusterRoleBinding" {
+		return nil
+	}
+	subjects, found, err := unstructured.NestedSlice(obj.Object, "subjects")
+	if err != nil || !found {
+		return err
+	}
+
+	kept := make([]interface{}, 0, len(subjects))
+	for _, s := range subjects {
+		subject, _ := s.(map[string]interface{})
+		if subject["kind"] == "ServiceAccount" && subject["namespace"] == namespace {
+			continue
+		}
+		kept = append(kept, s)
+	}
+
+	return unstructured.SetNestedSlice(obj.Object, kept, "subjects")
+}
+
+func subjectKey(s interface{}) string {
+	subject, _ := s.(map[string]interface{})
+
+	return fmt.Sprintf("%v/%v/%v", subject["kind"], subject["namespace"], subject["name"])
+}
+
+func gvkOf(owner metav1.Object, scheme *runtime.Scheme) (schema.GroupVersionKind, error) {
+	obj, ok := owner.(runtime.Object)
+	if !ok {
+		return schema.GroupVersionKind{}, fmt.Errorf("owner %s is not a runtime.Object", owner.GetName())
+	}
+
+	return apiutil.GVKForObject(obj, scheme)
+}
+
+func isOfKind(ref metav1.OwnerReference, gvk schema.GroupVersionKind) bool {
+	gv, err := schema.ParseGroupVersion(ref.APIVersion)
+
+	return err == nil && gv.Group == gvk.Group && ref.Kind == gvk.Kind
+}
@@ -0,0 +1,257 @@
+package deploy
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/components"
+)
+
+// manifestsSource fetches the manifests referenced by the URI of a ManifestsConfig into the target folder.
+type manifestsSource func(ctx context.Context, cli client.Client, uri *url.URL, target string, manifestConfig components.ManifestsConfig) error
+
+// manifestsSources lists the supported sources of manifests, by URI scheme.
+var manifestsSources = map[string]manifestsSource{
+	"http":      fromHTTP,
+	"https":     fromHTTP,
+	"oci":       fromOCI,
+	"file":      fromFile,
+	"configmap": fromConfigMap,
+}
+
+// LocalManifestsPath is the directory of the operator container which file:// URIs are restricted to, volumes holding
+// manifests being mounted in it, e.g. file:///mnt/manifests/dashboard.
+var LocalManifestsPath = "/mnt/manifests"
+
+// ValidateManifestsURI checks the URI of a ManifestsConfig refers to a supported source of manifests, without fetching them.
+func ValidateManifestsURI(manifestsURI string) error {
+	uri, err := url.Parse(manifestsURI)
+	if err != nil {
+		return err
+	}
+	if _, found := manifestsSources[uri.Scheme]; !found {
+		schemes := make([]string, 0, len(manifestsSources))
+		for scheme := range manifestsSources {
+			schemes = append(schemes, scheme)
+		}
+		sort.Strings(schemes)
+
+		return fmt.Errorf("unsupported scheme %q, expected one of %s", uri.Scheme, strings.Join(schemes, ", "))
+	}
+
+	switch uri.Scheme {
+	case "oci":
+		_, err = parseOCIReference(uri)
+
+		return err
+	case "file":
+		if !filepath.IsAbs(uri.Path) || !isWithin(filepath.Clean(uri.Path), LocalManifestsPath) {
+			return fmt.Errorf("file URIs require an absolute path in %s, e.g. file://%s", LocalManifestsPath,
+				filepath.Join(LocalManifestsPath, "dashboard"))
+		}
+	case "configmap":
+		_, err = parseConfigMapReference(uri)
+
+		return err
+	default:
+		if uri.Host == "" {
+			return errors.New("missing host")
+		}
+	}
+
+	return nil
+}
+
+// fromHTTP extracts manifests from a tarball, e.g. https://github.com/org/repo/tarball/<tag/branch>.
+func fromHTTP(ctx context.Context, _ client.Client, _ *url.URL, target string, manifestConfig components.ManifestsConfig) error {
+	cache, cleanup, err := manifestsCacheFrom(ctx)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	archive, err := cache.fetchArchive(ctx, manifestConfig)
+	if err != nil {
+		return err
+	}
+
+	return extractManifests(archive, target, manifestConfig.ContextDir)
+}
+
+// fromFile copies manifests from a directory, such as a ConfigMap mounted in the operator container, or extracts them
+// from a tarball available on the filesystem, e.g. file:///mnt/manifests/dashboard. Only files of LocalManifestsPath
+// are read, symbolic links leading out of it are rejected.
+func fromFile(_ context.Context, _ client.Client, uri *url.URL, target string, manifestConfig components.ManifestsConfig) error {
+	mountPath, err := filepath.EvalSymlinks(LocalManifestsPath)
+	if err != nil {
+		return fmt.Errorf("error reading manifests: %w", err)
+	}
+	if err := verifyLocalPath(uri.Path, mountPath); err != nil {
+		return err
+	}
+	info, err := os.Stat(uri.Path)
+	if err != nil {
+		return fmt.Errorf("error reading manifests: %w", err)
+	}
+
+	if !info.IsDir() {
+		if err := verifyChecksum(uri.Path, manifestConfig.SHA256); err != nil {
+			return err
+		}
+
+		return extractManifests(uri.Path, target, manifestConfig.ContextDir)
+	}
+
+	root := filepath.Join(uri.Path, manifestConfig.ContextDir)
+	if !isWithin(root, filepath.Clean(uri.Path)) {
+		return fmt.Errorf("invalid context directory %s of manifests URI %s", manifestConfig.ContextDir, manifestConfig.URI)
+	}
+	err = filepath.WalkDir(root, func(path string, _ fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		return verifyLocalPath(path, mountPath)
+	})
+	if err != nil {
+		return err
+	}
+
+	return copyManifests(root, target)
+}
+
+// verifyLocalPath checks the path, once symbolic links are resolved, is in the directory manifests are mounted in.
+func verifyLocalPath(path, mountPath string) error {
+	resolved, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return fmt.Errorf("error reading manifests: %w", err)
+	}
+	if !isWithin(resolved, mountPath) {
+		return fmt.Errorf("invalid manifests path %s, it leads out of %s", path, LocalManifestsPath)
+	}
+
+	return nil
+}
+
+// fromConfigMap writes the files held by a ConfigMap, e.g. configmap://<namespace>/<name>, each key being the name of a
+// file. ConfigMaps hold a single folder, thus contextDir is not used.
+func fromConfigMap(ctx context.Context, cli client.Client, uri *url.URL, target string, _ components.ManifestsConfig) error {
+	key, err := parseConfigMapReference(uri)
+	if err != nil {
+		return err
+	}
+	configMap := &corev1.ConfigMap{}
+	if err := cli.Get(ctx, key, configMap); err != nil {
+		return fmt.Errorf("error reading manifests from ConfigMap %s: %w", key, err)
+	}
+
+	files := make(map[string][]byte, len(configMap.Data)+len(configMap.BinaryData))
+	for name, content := range configMap.Data {
+		files[name] = []byte(content)
+	}
+	for name, content := range configMap.BinaryData {
+		files[name] = content
+	}
+	for name, content := range files {
+		if filepath.Base(name) != name || strings.HasPrefix(name, "..") {
+			return fmt.Errorf("invalid file name %s in ConfigMap %s", name, key)
+		}
+		if err := extractFile(bytes.NewReader(content), filepath.Join(target, name)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parseConfigMapReference returns the namespace and name of the ConfigMap referenced by a configmap:// URI.
+func parseConfigMapReference(uri *url.URL) (types.NamespacedName, error) {
+	name := strings.Trim(uri.Path, "/")
+	if uri.Host == "" || name == "" || strings.Contains(name, "/") {
+		return types.NamespacedName{}, errors.New("configmap URIs require a namespace and a name, e.g. configmap://opendatahub/manifests")
+	}
+
+	return types.NamespacedName{Namespace: uri.Host, Name: name}, nil
+}
+
+func verifyChecksum(path, expected string) error {
+	if expected == "" {
+		return nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("error reading manifests: %w", err)
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return fmt.Errorf("error reading manifests: %w", err)
+	}
+	if digest := hex.EncodeToString(hash.Sum(nil)); digest != strings.ToLower(expected) {
+		return fmt.Errorf("checksum mismatch of %s: expected sha256 %s, got %s", path, expected, digest)
+	}
+
+	return nil
+}
+
+// copyManifests copies the files of the directory, following symbolic links as ConfigMap volumes are made of them.
+// Hidden entries starting with "..", which hold the content of ConfigMap volumes, are skipped.
+func copyManifests(root, target string) error {
+	return filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path != root && strings.HasPrefix(entry.Name(), "..") {
+			if entry.IsDir() {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		relativePath, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			return fmt.Errorf("error reading manifests: %w", err)
+		}
+		if info.IsDir() {
+			return os.MkdirAll(filepath.Join(target, relativePath), os.ModePerm)
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		source, err := os.Open(path)
+		if err != nil {
+			return fmt.Errorf("error reading manifests: %w", err)
+		}
+		defer source.Close()
+
+		return extractFile(source, filepath.Join(target, relativePath))
+	})
+}
+
+// isWithin tells whether path is dir or one of its descendants.
+func isWithin(path, dir string) bool {
+	return path == dir || strings.HasPrefix(path, dir+string(os.PathSeparator))
+}
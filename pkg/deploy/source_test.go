@@ -0,0 +1,80 @@
+package deploy_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/components"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Reading manifests from the operator container", func() {
+
+	var (
+		mountPath string
+		target    string
+	)
+
+	fetch := func(path string) error {
+		return deploy.FetchManifests(context.Background(), fake.NewClientBuilder().Build(), target, components.ManifestsConfig{
+			URI:        "file://" + path,
+			ContextDir: ".",
+		})
+	}
+
+	BeforeEach(func() {
+		mountPath = GinkgoT().TempDir()
+		target = GinkgoT().TempDir()
+
+		localManifestsPath := deploy.LocalManifestsPath
+		deploy.LocalManifestsPath = mountPath
+		DeferCleanup(func() {
+			deploy.LocalManifestsPath = localManifestsPath
+		})
+
+		Expect(os.MkdirAll(filepath.Join(mountPath, "dashboard"), 0o755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(mountPath, "dashboard", "kustomization.yaml"), []byte("resources: []"), 0o600)).To(Succeed())
+	})
+
+	It("should copy a directory of the mounted manifests", func() {
+		Expect(fetch(filepath.Join(mountPath, "dashboard"))).To(Succeed())
+
+		Expect(filepath.Join(target, "kustomization.yaml")).To(BeARegularFile())
+	})
+
+	DescribeTable("should validate that paths are within the mounted manifests",
+		func(path func() string, valid bool) {
+			err := deploy.ValidateManifestsURI("file://" + path())
+			if valid {
+				Expect(err).ToNot(HaveOccurred())
+			} else {
+				Expect(err).To(MatchError(ContainSubstring("file URIs require an absolute path in " + mountPath)))
+			}
+		},
+		Entry("directory of the mounted manifests", func() string { return filepath.Join(mountPath, "dashboard") }, true),
+		Entry("directory outside of the mounted manifests", func() string { return "/etc" }, false),
+		Entry("path leading out of the mounted manifests", func() string { return mountPath + "/../etc" }, false),
+	)
+
+	It("should not read a directory outside of the mounted manifests", func() {
+		Expect(fetch(filepath.Dir(mountPath))).To(MatchError(ContainSubstring("it leads out of")))
+	})
+
+	It("should not follow symlinks leading out of the mounted manifests", func() {
+		outside := GinkgoT().TempDir()
+		Expect(os.WriteFile(filepath.Join(outside, "secret.yaml"), []byte("kind: Secret"), 0o600)).To(Succeed())
+
+		Expect(os.Symlink(outside, filepath.Join(mountPath, "escape"))).To(Succeed())
+		Expect(fetch(filepath.Join(mountPath, "escape"))).To(MatchError(ContainSubstring("it leads out of")))
+
+		Expect(os.Symlink(filepath.Join(outside, "secret.yaml"), filepath.Join(mountPath, "dashboard", "secret.yaml"))).To(Succeed())
+		Expect(fetch(filepath.Join(mountPath, "dashboard"))).To(MatchError(ContainSubstring("it leads out of")))
+		Expect(filepath.Join(target, "secret.yaml")).ToNot(BeAnExistingFile())
+	})
+})
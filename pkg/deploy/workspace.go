@@ -0,0 +1,163 @@
+package deploy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/components"
+)
+
+// ManifestsWorkspacePath is the directory workspaces are created in.
+var ManifestsWorkspacePath = filepath.Join(os.TempDir(), "odh-manifests-workspaces")
+
+// Workspace is a scratch copy of the manifests tree, used by a single component reconcile. The pristine tree in
+// DefaultManifestPath is never modified: folders are copied into the workspace before the component renders them, so
+// params.env substitutions and manifests downloaded for DevFlags do not leak into other reconciles, and are discarded
+// once DevFlags are removed.
+//
+// Folders not used by the component are linked to the pristine tree, so kustomizations can still refer to them.
+type Workspace struct {
+	dir string
+	// pristine is the manifests tree the workspace is created from
+	pristine string
+
+	mu sync.Mutex
+	// copied lists folders replaced by a copy, or by downloaded manifests
+	copied map[string]bool
+}
+
+// NewWorkspace creates a workspace for the given component, in which the given folders of the manifests tree are copied,
+// as the component modifies them. It has to be removed with Close once manifests are deployed.
+func NewWorkspace(componentName string, folders ...string) (*Workspace, error) {
+	return newWorkspace(DefaultManifestPath, componentName, folders...)
+}
+
+func newWorkspace(pristine, componentName string, folders ...string) (*Workspace, error) {
+	if err := os.MkdirAll(ManifestsWorkspacePath, os.ModePerm); err != nil {
+		return nil, fmt.Errorf("error creating manifests workspace: %w", err)
+	}
+	dir, err := os.MkdirTemp(ManifestsWorkspacePath, componentName+"-")
+	if err != nil {
+		return nil, fmt.Errorf("error creating manifests workspace: %w", err)
+	}
+	workspace := &Workspace{dir: dir, pristine: pristine, copied: map[string]bool{}}
+
+	entries, err := os.ReadDir(pristine)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, errors.Join(fmt.Errorf("error reading manifests: %w", err), workspace.Close())
+	}
+	for _, entry := range entries {
+		if err := os.Symlink(filepath.Join(pristine, entry.Name()), filepath.Join(dir, entry.Name())); err != nil {
+			return nil, errors.Join(fmt.Errorf("error creating manifests workspace: %w", err), workspace.Close())
+		}
+	}
+	for _, folder := range folders {
+		if err := workspace.copy(folder); err != nil {
+			return nil, errors.Join(err, workspace.Close())
+		}
+	}
+
+	return workspace, nil
+}
+
+// Close removes the workspace.
+func (w *Workspace) Close() error {
+	return os.RemoveAll(w.dir)
+}
+
+// Path returns the path of an element of a folder of the manifests tree in the workspace, e.g. Path("kserve", "overlays/odh").
+// Only folders passed to NewWorkspace or Resolve, or nested in them, can be modified.
+func (w *Workspace) Path(folder string, elem ...string) string {
+	return filepath.Join(append([]string{w.dir, folder}, elem...)...)
+}
+
+// Resolve returns the path of the manifests to render from a folder, i.e. defaultPath within the folder. When manifestConfig
+// is not nil, the folder is replaced by the manifests it refers to, and its sourcePath is used when set.
+func (w *Workspace) Resolve(ctx context.Context, cli client.Client, folder, defaultPath string,
+	manifestConfig *components.ManifestsConfig,
+) (string, error) {
+	if manifestConfig == nil {
+		return w.Path(folder, defaultPath), w.copy(folder)
+	}
+
+	if err := w.download(ctx, cli, folder, *manifestConfig); err != nil {
+		return "", err
+	}
+	if inventory := inventoryFrom(ctx); inventory != nil {
+		inventory.recordManifestsSource(manifestConfig.URI)
+	}
+	if manifestConfig.SourcePath != "" {
+		return w.Path(folder, manifestConfig.SourcePath), nil
+	}
+
+	return w.Path(folder, defaultPath), nil
+}
+
+// Fetch replaces the folder by the manifests a component ships outside of the operator, and returns the path of the
+// manifests to render. Unlike manifests set in DevFlags, they are not recorded as a manifests source in the inventory.
+func (w *Workspace) Fetch(ctx context.Context, cli client.Client, folder string, manifestConfig components.ManifestsConfig) (string, error) {
+	if err := w.download(ctx, cli, folder, manifestConfig); err != nil {
+		return "", err
+	}
+
+	return w.Path(folder, manifestConfig.SourcePath), nil
+}
+
+// copy replaces the link to the top-level folder containing folder by a copy of the pristine tree.
+func (w *Workspace) copy(folder string) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.copyLocked(folder)
+}
+
+func (w *Workspace) copyLocked(folder string) error {
+	root, _, _ := strings.Cut(filepath.ToSlash(folder), "/")
+	if w.copied[root] {
+		return nil
+	}
+	target := filepath.Join(w.dir, root)
+	if err := os.Remove(target); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("error preparing manifests of %s: %w", root, err)
+	}
+	pristine := filepath.Join(w.pristine, root)
+	if _, err := os.Stat(pristine); err == nil {
+		if err := copyManifests(pristine, target); err != nil {
+			return fmt.Errorf("error preparing manifests of %s: %w", root, err)
+		}
+	}
+	w.copied[root] = true
+
+	return nil
+}
+
+// download replaces the folder with the manifests the config refers to.
+func (w *Workspace) download(ctx context.Context, cli client.Client, folder string, manifestConfig components.ManifestsConfig) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	root, nested, _ := strings.Cut(filepath.ToSlash(folder), "/")
+	// Nested folders are replaced within a copy of their top-level folder, which is linked to the pristine tree until then
+	if nested != "" {
+		if err := w.copyLocked(root); err != nil {
+			return err
+		}
+	}
+	target := filepath.Join(w.dir, folder)
+	if err := os.RemoveAll(target); err != nil {
+		return fmt.Errorf("error preparing manifests of %s: %w", folder, err)
+	}
+	if err := DownloadManifests(ctx, cli, target, manifestConfig); err != nil {
+		return err
+	}
+	w.copied[root] = true
+
+	return nil
+}
@@ -0,0 +1,171 @@
+package deploy_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/components"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Rendering manifests from a workspace", func() {
+
+	var (
+		ctx       context.Context
+		pristine  string
+		workspace *deploy.Workspace
+	)
+
+	writeFile := func(path, content string) {
+		Expect(os.MkdirAll(filepath.Dir(path), os.ModePerm)).To(Succeed())
+		Expect(os.WriteFile(path, []byte(content), 0o600)).To(Succeed())
+	}
+
+	manifestsConfigMap := func(files map[string]string) client.Client {
+		return fake.NewClientBuilder().WithObjects(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "dashboard-manifests", Namespace: "opendatahub"},
+			Data:       files,
+		}).Build()
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		pristine = GinkgoT().TempDir()
+		previousPath := deploy.ManifestsWorkspacePath
+		deploy.ManifestsWorkspacePath = GinkgoT().TempDir()
+		DeferCleanup(func() {
+			deploy.ManifestsWorkspacePath = previousPath
+		})
+
+		writeFile(filepath.Join(pristine, "dashboard", "base", "params.env"), "image=<image>")
+		writeFile(filepath.Join(pristine, "dashboard", "overlays", "odh", "kustomization.yaml"), "resources: [../../base]")
+		writeFile(filepath.Join(pristine, "common", "kustomization.yaml"), "resources: []")
+	})
+
+	AfterEach(func() {
+		if workspace != nil {
+			Expect(workspace.Close()).To(Succeed())
+			workspace = nil
+		}
+	})
+
+	It("should copy the folders of the component and link the other ones to the pristine tree", func() {
+		var err error
+		workspace, err = deploy.NewWorkspaceFrom(pristine, "dashboard", "dashboard")
+		Expect(err).ToNot(HaveOccurred())
+
+		dashboard, err := os.Lstat(workspace.Path("dashboard"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(dashboard.IsDir()).To(BeTrue())
+		common, err := os.Lstat(workspace.Path("common"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(common.Mode() & os.ModeSymlink).ToNot(BeZero())
+		Expect(os.Readlink(workspace.Path("common"))).To(Equal(filepath.Join(pristine, "common")))
+	})
+
+	It("should leave the pristine tree unchanged when files of the workspace are modified", func() {
+		var err error
+		workspace, err = deploy.NewWorkspaceFrom(pristine, "dashboard", "dashboard")
+		Expect(err).ToNot(HaveOccurred())
+
+		writeFile(workspace.Path("dashboard", "base", "params.env"), "image=quay.io/org/dashboard")
+
+		Expect(os.ReadFile(filepath.Join(pristine, "dashboard", "base", "params.env"))).To(Equal([]byte("image=<image>")))
+	})
+
+	It("should create a workspace without the folders missing from the pristine tree", func() {
+		var err error
+		workspace, err = deploy.NewWorkspaceFrom(filepath.Join(pristine, "missing"), "dashboard", "dashboard")
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(workspace.Path("dashboard")).ToNot(BeAnExistingFile())
+	})
+
+	It("should remove the workspace when it is closed", func() {
+		var err error
+		workspace, err = deploy.NewWorkspaceFrom(pristine, "dashboard", "dashboard")
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(workspace.Close()).To(Succeed())
+
+		Expect(filepath.Dir(workspace.Path("dashboard"))).ToNot(BeAnExistingFile())
+		Expect(filepath.Join(pristine, "dashboard", "base", "params.env")).To(BeARegularFile())
+		workspace = nil
+	})
+
+	When("resolving the manifests of a folder", func() {
+
+		BeforeEach(func() {
+			var err error
+			workspace, err = deploy.NewWorkspaceFrom(pristine, "dashboard")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should copy the built-in manifests when no manifests are set", func() {
+			inventory := deploy.NewInventory()
+
+			path, err := workspace.Resolve(deploy.WithInventory(ctx, inventory), nil, "dashboard", "overlays/odh", nil)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(path).To(Equal(workspace.Path("dashboard", "overlays/odh")))
+			info, err := os.Lstat(workspace.Path("dashboard"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.IsDir()).To(BeTrue())
+			Expect(inventory.ManifestsSources()).To(BeEmpty())
+		})
+
+		It("should replace the folder with the downloaded manifests and record their source", func() {
+			cli := manifestsConfigMap(map[string]string{"kustomization.yaml": "resources: [deployment.yaml]"})
+			inventory := deploy.NewInventory()
+			config := &components.ManifestsConfig{URI: "configmap://opendatahub/dashboard-manifests"}
+
+			path, err := workspace.Resolve(deploy.WithInventory(ctx, inventory), cli, "dashboard", "overlays/odh", config)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(path).To(Equal(workspace.Path("dashboard", "overlays/odh")))
+			Expect(os.ReadFile(workspace.Path("dashboard", "kustomization.yaml"))).To(Equal([]byte("resources: [deployment.yaml]")))
+			Expect(workspace.Path("dashboard", "base")).ToNot(BeAnExistingFile())
+			Expect(inventory.ManifestsSources()).To(ConsistOf(config.URI))
+		})
+
+		It("should use the source path of the downloaded manifests", func() {
+			cli := manifestsConfigMap(map[string]string{"kustomization.yaml": "resources: []"})
+			config := &components.ManifestsConfig{URI: "configmap://opendatahub/dashboard-manifests", SourcePath: "."}
+
+			path, err := workspace.Resolve(ctx, cli, "dashboard", "overlays/odh", config)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(path).To(Equal(workspace.Path("dashboard")))
+		})
+
+		It("should only replace a nested folder, keeping a copy of the rest of its top-level folder", func() {
+			cli := manifestsConfigMap(map[string]string{"kustomization.yaml": "resources: [route.yaml]"})
+			config := &components.ManifestsConfig{URI: "configmap://opendatahub/dashboard-manifests"}
+
+			_, err := workspace.Resolve(ctx, cli, "dashboard/overlays", "odh", config)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(os.ReadFile(workspace.Path("dashboard", "overlays", "kustomization.yaml"))).To(Equal([]byte("resources: [route.yaml]")))
+			Expect(workspace.Path("dashboard", "overlays", "odh")).ToNot(BeAnExistingFile())
+			Expect(os.ReadFile(workspace.Path("dashboard", "base", "params.env"))).To(Equal([]byte("image=<image>")))
+			Expect(filepath.Join(pristine, "dashboard", "overlays", "odh", "kustomization.yaml")).To(BeARegularFile())
+		})
+
+		It("should fail when the manifests can not be downloaded", func() {
+			config := &components.ManifestsConfig{URI: "configmap://opendatahub/missing"}
+
+			_, err := workspace.Resolve(ctx, manifestsConfigMap(nil), "dashboard", "overlays/odh", config)
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
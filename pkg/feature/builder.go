@@ -113,6 +113,18 @@ func (fb *featureBuilder) Managed() *featureBuilder {
 	return fb
 }
 
+// DependsOn declares the features, loaded by the same FeaturesHandler, which have to be applied before this one.
+// The feature is skipped when one of them fails, and removed before them.
+func (fb *featureBuilder) DependsOn(names ...string) *featureBuilder {
+	fb.builders = append(fb.builders, func(f *Feature) error {
+		f.Dependencies = append(f.Dependencies, names...)
+
+		return nil
+	})
+
+	return fb
+}
+
 // WithData adds data loaders to the feature. This way you can define what data should be loaded before the feature is applied.
 // This can be later used in templates and when creating resources programmatically.
 func (fb *featureBuilder) WithData(loader ...Action) *featureBuilder {
@@ -180,16 +192,21 @@ func (fb *featureBuilder) OnDelete(cleanups ...Action) *featureBuilder {
 func (fb *featureBuilder) Load() error {
 	feature := newFeature(fb.name)
 
-	// UsingConfig builder wasn't called while constructing this feature.
-	// Get default settings and create needed clients.
-	if fb.config == nil {
-		if err := fb.withDefaultClient(); err != nil {
-			return err
+	if fb.featuresHandler.client != nil {
+		// Rendering features does not require access to the cluster
+		feature.Client = fb.featuresHandler.client
+	} else {
+		// UsingConfig builder wasn't called while constructing this feature.
+		// Get default settings and create needed clients.
+		if fb.config == nil {
+			if err := fb.withDefaultClient(); err != nil {
+				return err
+			}
 		}
-	}
 
-	if err := createClient(fb.config)(feature); err != nil {
-		return err
+		if err := createClient(fb.config)(feature); err != nil {
+			return err
+		}
 	}
 
 	for i := range fb.builders {
@@ -0,0 +1,105 @@
+package feature
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ActionFactory creates an action from the arguments given to it in a feature declaration.
+type ActionFactory func(args ...string) (Action, error)
+
+// The catalog lists the actions which can be used as preconditions, data loaders and postconditions of declared
+// features. Packages providing actions register them from their init function, as for components.
+var (
+	catalogMutex sync.RWMutex
+	catalog      = map[string]ActionFactory{}
+)
+
+func init() { //nolint:gochecknoinits
+	RegisterAction("EnsureOperatorIsInstalled", WithArgs(1, func(args []string) Action {
+		return EnsureOperatorIsInstalled(args[0])
+	}))
+	RegisterAction("WaitForPodsToBeReady", WithArgs(1, func(args []string) Action {
+		return WaitForPodsToBeReady(args[0])
+	}))
+	RegisterAction("WaitForResourceToBeCreated", func(args ...string) (Action, error) {
+		if len(args) != 3 {
+			return nil, fmt.Errorf("expected namespace, apiVersion and kind, got %d arguments", len(args))
+		}
+		gv, err := schema.ParseGroupVersion(args[1])
+		if err != nil {
+			return nil, err
+		}
+
+		return WaitForResourceToBeCreated(args[0], gv.WithKind(args[2])), nil
+	})
+	RegisterAction("CreateNamespaceIfNotExists", WithArgs(1, func(args []string) Action {
+		return CreateNamespaceIfNotExists(args[0])
+	}))
+}
+
+// RegisterAction adds an action to the catalog. It panics when an action of the same name is already registered, as
+// actions are registered once at startup.
+func RegisterAction(name string, factory ActionFactory) {
+	catalogMutex.Lock()
+	defer catalogMutex.Unlock()
+
+	if _, found := catalog[name]; found {
+		panic(fmt.Sprintf("action %s is already registered", name))
+	}
+	catalog[name] = factory
+}
+
+// RegisteredActions returns the names of the actions of the catalog, sorted by name.
+func RegisteredActions() []string {
+	catalogMutex.RLock()
+	defer catalogMutex.RUnlock()
+
+	names := make([]string, 0, len(catalog))
+	for name := range catalog {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// WithoutArgs registers an action which does not take arguments.
+func WithoutArgs(action Action) ActionFactory {
+	return WithArgs(0, func([]string) Action {
+		return action
+	})
+}
+
+// WithArgs registers an action created from the given number of arguments.
+func WithArgs(count int, create func(args []string) Action) ActionFactory {
+	return func(args ...string) (Action, error) {
+		if len(args) != count {
+			return nil, fmt.Errorf("expected %d arguments, got %d", count, len(args))
+		}
+
+		return create(args), nil
+	}
+}
+
+// catalogAction creates the action of the catalog referenced by a feature declaration.
+func catalogAction(ref ActionRef) (Action, error) {
+	catalogMutex.RLock()
+	factory, found := catalog[ref.Name]
+	catalogMutex.RUnlock()
+
+	if !found {
+		return nil, fmt.Errorf("unknown action %q, available actions are: %s", ref.Name, strings.Join(RegisteredActions(), ", "))
+	}
+
+	action, err := factory(ref.Args...)
+	if err != nil {
+		return nil, fmt.Errorf("invalid arguments of action %s: %w", ref.Name, err)
+	}
+
+	return action, nil
+}
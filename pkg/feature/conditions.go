@@ -3,22 +3,15 @@ package feature
 import (
 	"context"
 	"fmt"
-	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
-	"k8s.io/apimachinery/pkg/util/wait"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 )
 
-const (
-	interval = 2 * time.Second
-	duration = 5 * time.Minute
-)
-
 type MissingOperatorError struct {
 	operatorName string
 	err          error
@@ -52,78 +45,74 @@ func EnsureOperatorIsInstalled(operatorName string) Action {
 	}
 }
 
+// WaitForPodsToBeReady checks whether all the pods of the namespace are ready, returning a cluster.NotReadyError while
+// they are not, or while there are none yet.
 func WaitForPodsToBeReady(namespace string) Action {
 	return func(ctx context.Context, f *Feature) error {
-		f.Log.Info("waiting for pods to become ready", "namespace", namespace, "duration (s)", duration.Seconds())
-
-		return wait.PollUntilContextTimeout(ctx, interval, duration, false, func(ctx context.Context) (bool, error) {
-			var podList corev1.PodList
+		var podList corev1.PodList
 
-			err := f.Client.List(ctx, &podList, client.InNamespace(namespace))
-			if err != nil {
-				return false, err
-			}
+		err := f.Client.List(ctx, &podList, client.InNamespace(namespace))
+		if err != nil {
+			return err
+		}
 
-			readyPods := 0
-			totalPods := len(podList.Items)
+		readyPods := 0
+		totalPods := len(podList.Items)
 
-			if totalPods == 0 { // We want to wait for "something", so make sure we have "something" before we claim success.
-				return false, nil
-			}
+		if totalPods == 0 { // We want to wait for "something", so make sure we have "something" before we claim success.
+			return cluster.NewNotReadyError("waiting for pods to be created in namespace %s", namespace)
+		}
 
-			for _, pod := range podList.Items {
-				podReady := true
-				// Consider a "PodSucceeded" as ready, since these will never will
-				// be in Ready condition (i.e. Jobs that already completed).
-				if pod.Status.Phase != corev1.PodSucceeded {
-					for _, condition := range pod.Status.Conditions {
-						if condition.Type == corev1.PodReady {
-							if condition.Status != corev1.ConditionTrue {
-								podReady = false
-
-								break
-							}
+		for _, pod := range podList.Items {
+			podReady := true
+			// Consider a "PodSucceeded" as ready, since these will never will
+			// be in Ready condition (i.e. Jobs that already completed).
+			if pod.Status.Phase != corev1.PodSucceeded {
+				for _, condition := range pod.Status.Conditions {
+					if condition.Type == corev1.PodReady {
+						if condition.Status != corev1.ConditionTrue {
+							podReady = false
+
+							break
 						}
 					}
 				}
-				if podReady {
-					readyPods++
-				}
 			}
+			if podReady {
+				readyPods++
+			}
+		}
 
-			done := readyPods == totalPods
+		if readyPods != totalPods {
+			f.Log.Info("waiting for pods to become ready", "namespace", namespace, "ready", readyPods, "total", totalPods)
 
-			if done {
-				f.Log.Info("done waiting for pods to become ready", "namespace", namespace)
-			}
+			return cluster.NewNotReadyError("%d/%d pods are ready in namespace %s", readyPods, totalPods, namespace)
+		}
 
-			return done, nil
-		})
+		return nil
 	}
 }
 
+// WaitForResourceToBeCreated checks whether a resource of the given kind exists in the namespace, returning a
+// cluster.NotReadyError while there is none.
 func WaitForResourceToBeCreated(namespace string, gvk schema.GroupVersionKind) Action {
 	return func(ctx context.Context, f *Feature) error {
-		f.Log.Info("waiting for resource to be created", "namespace", namespace, "resource", gvk)
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(gvk)
 
-		return wait.PollUntilContextTimeout(ctx, interval, duration, false, func(ctx context.Context) (bool, error) {
-			list := &unstructured.UnstructuredList{}
-			list.SetGroupVersionKind(gvk)
+		err := f.Client.List(ctx, list, client.InNamespace(namespace), client.Limit(1))
+		if err != nil {
+			f.Log.Error(err, "failed waiting for resource", "namespace", namespace, "resource", gvk)
 
-			err := f.Client.List(ctx, list, client.InNamespace(namespace), client.Limit(1))
-			if err != nil {
-				f.Log.Error(err, "failed waiting for resource", "namespace", namespace, "resource", gvk)
-
-				return false, err
-			}
+			return err
+		}
 
-			if len(list.Items) > 0 {
-				f.Log.Info("resource created", "namespace", namespace, "resource", gvk)
+		if len(list.Items) == 0 {
+			f.Log.Info("waiting for resource to be created", "namespace", namespace, "resource", gvk)
 
-				return true, nil
-			}
+			return cluster.NewNotReadyError("waiting for %s to be created in namespace %s", gvk.Kind, namespace)
+		}
 
-			return false, nil
-		})
+		return nil
 	}
 }
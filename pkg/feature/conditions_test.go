@@ -0,0 +1,75 @@
+package feature_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/hashicorp/go-multierror"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Waiting for pods to be ready", func() {
+
+	const namespace = "waiting-namespace"
+
+	pod := func(name string, ready corev1.ConditionStatus) client.Object {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Status: corev1.PodStatus{
+				Phase:      corev1.PodRunning,
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: ready}},
+			},
+		}
+	}
+
+	waitForPods := func(objects ...client.Object) error {
+		f := &feature.Feature{
+			Name:   "waiting-feature",
+			Client: fake.NewClientBuilder().WithObjects(objects...).Build(),
+			Log:    logr.Discard(),
+		}
+
+		return feature.WaitForPodsToBeReady(namespace)(context.Background(), f)
+	}
+
+	It("should not be ready while there are no pods", func() {
+		err := waitForPods()
+
+		Expect(cluster.IsNotReady(err)).To(BeTrue())
+	})
+
+	It("should report the progress of pods not ready yet", func() {
+		err := waitForPods(pod("ready", corev1.ConditionTrue), pod("starting", corev1.ConditionFalse))
+
+		notReady, waiting := cluster.AsNotReady(err)
+		Expect(waiting).To(BeTrue())
+		Expect(notReady.Message).To(Equal("1/2 pods are ready in namespace " + namespace))
+		Expect(notReady.RequeueAfter).To(Equal(cluster.DefaultRequeueAfter))
+	})
+
+	It("should succeed once all pods are ready", func() {
+		Expect(waitForPods(pod("ready", corev1.ConditionTrue))).To(Succeed())
+	})
+
+	It("should only wait when all aggregated errors are waiting", func() {
+		waitingErrors := multierror.Append(nil,
+			fmt.Errorf("precondition: %w", cluster.NewNotReadyError("waiting for a")),
+			cluster.NewNotReadyError("waiting for b"),
+		)
+		Expect(cluster.IsNotReady(waitingErrors)).To(BeTrue())
+
+		failedErrors := multierror.Append(waitingErrors, fmt.Errorf("failed"))
+		Expect(cluster.IsNotReady(failedErrors)).To(BeFalse())
+		Expect(cluster.IsNotReady(nil)).To(BeFalse())
+	})
+})
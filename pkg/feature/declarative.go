@@ -0,0 +1,252 @@
+package feature
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strings"
+	"testing/fstest"
+
+	"github.com/hashicorp/go-multierror"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+)
+
+// Features can be declared in a ConfigMap instead of being built in Go, so features specific to a cluster are added
+// without rebuilding the operator. Each key of the ConfigMap ending with DefinitionSuffix holds the Definition of a
+// feature, while the other keys hold the manifests it references, which follow the naming conventions of embedded
+// manifests, i.e. templates contain ".tmpl." and patches ".patch." in their names.
+
+// DefinitionSuffix is the suffix of the keys of a ConfigMap holding feature definitions.
+const DefinitionSuffix = ".feature.yaml"
+
+// Definition declares a feature applied from manifests, using actions of the catalog as preconditions, data loaders
+// and postconditions.
+type Definition struct {
+	Name string `json:"name"`
+	// TargetNamespace is the namespace the feature is applied to, the applications namespace by default.
+	TargetNamespace string `json:"targetNamespace,omitempty"`
+	// Managed marks the objects of the feature as managed by the operator, which reverts changes made to them.
+	Managed bool `json:"managed,omitempty"`
+	// DependsOn lists the features declared in the same ConfigMap which have to be applied before this one.
+	DependsOn []string `json:"dependsOn,omitempty"`
+	// Manifests are the keys of the ConfigMap holding the manifests of the feature.
+	Manifests      []string    `json:"manifests,omitempty"`
+	PreConditions  []ActionRef `json:"preconditions,omitempty"`
+	Data           []ActionRef `json:"data,omitempty"`
+	PostConditions []ActionRef `json:"postconditions,omitempty"`
+}
+
+// ActionRef references an action of the catalog, along with its arguments.
+type ActionRef struct {
+	Name string   `json:"name"`
+	Args []string `json:"args,omitempty"`
+}
+
+// ParseDefinitions reads the features declared in the data of a ConfigMap, sorted by key, along with the manifests
+// they reference.
+func ParseDefinitions(data map[string]string) ([]Definition, fs.FS, error) {
+	manifests := fstest.MapFS{}
+	var keys []string
+	for key, content := range data {
+		if strings.HasSuffix(key, DefinitionSuffix) {
+			keys = append(keys, key)
+
+			continue
+		}
+		manifests[key] = &fstest.MapFile{Data: []byte(content)}
+	}
+	sort.Strings(keys)
+
+	parsed := make(map[string]Definition, len(keys))
+	declared := make(map[string]bool, len(keys))
+	var parseErrors *multierror.Error
+	for _, key := range keys {
+		definition := Definition{}
+		if err := yaml.UnmarshalStrict([]byte(data[key]), &definition); err != nil {
+			parseErrors = multierror.Append(parseErrors, fmt.Errorf("invalid feature definition %s: %w", key, err))
+
+			continue
+		}
+		parsed[key] = definition
+		declared[definition.Name] = true
+	}
+
+	definitions := make([]Definition, 0, len(keys))
+	for _, key := range keys {
+		definition, found := parsed[key]
+		if !found {
+			continue
+		}
+		if err := definition.validate(manifests, declared); err != nil {
+			parseErrors = multierror.Append(parseErrors, fmt.Errorf("invalid feature definition %s: %w", key, err))
+
+			continue
+		}
+		definitions = append(definitions, definition)
+	}
+
+	return definitions, manifests, parseErrors.ErrorOrNil()
+}
+
+// validate checks the definition only references manifests and features declared in the same ConfigMap, along with
+// actions of the catalog.
+func (d *Definition) validate(manifests fstest.MapFS, declared map[string]bool) error {
+	if d.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if builtInFeature(d.Name) {
+		return fmt.Errorf("name %s is reserved for a feature of the operator", d.Name)
+	}
+	for _, dependency := range d.DependsOn {
+		if !declared[dependency] {
+			return fmt.Errorf("dependency %s is not declared in the ConfigMap", dependency)
+		}
+	}
+	for _, manifest := range d.Manifests {
+		if _, found := manifests[manifest]; !found {
+			return fmt.Errorf("manifest %s is not part of the ConfigMap", manifest)
+		}
+	}
+	for _, refs := range [][]ActionRef{d.PreConditions, d.Data, d.PostConditions} {
+		for _, ref := range refs {
+			if _, err := catalogAction(ref); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// DeclaredFeaturesHandler applies the features declared in the ConfigMap, which are tracked as coming from it.
+// Features use a client created from the given config, or from the default one when it is nil.
+func DeclaredFeaturesHandler(dsci *dsciv1.DSCInitialization, configMap *corev1.ConfigMap, config *rest.Config) *FeaturesHandler {
+	return &FeaturesHandler{
+		DSCInitializationSpec: &dsci.Spec,
+		source:                featurev1.Source{Type: featurev1.DeclaredType, Name: configMap.Name},
+		featuresProviders:     []FeaturesProvider{declaredFeatures(configMap.Data, config)},
+	}
+}
+
+func declaredFeatures(data map[string]string, config *rest.Config) FeaturesProvider {
+	return func(handler *FeaturesHandler) error {
+		definitions, manifests, err := ParseDefinitions(data)
+		if err != nil {
+			return err
+		}
+
+		for _, definition := range definitions {
+			if err := definition.load(handler, manifests, config); err != nil {
+				return fmt.Errorf("failed loading feature %s: %w", definition.Name, err)
+			}
+		}
+
+		return nil
+	}
+}
+
+func (d *Definition) load(handler *FeaturesHandler, manifests fs.FS, config *rest.Config) error {
+	preconditions, err := catalogActions(d.PreConditions)
+	if err != nil {
+		return err
+	}
+	loaders, err := catalogActions(d.Data)
+	if err != nil {
+		return err
+	}
+	postconditions, err := catalogActions(d.PostConditions)
+	if err != nil {
+		return err
+	}
+
+	builder := CreateFeature(d.Name).
+		For(handler).
+		UsingConfig(config).
+		DependsOn(d.DependsOn...).
+		PreConditions(preconditions...).
+		WithData(loaders...).
+		PostConditions(postconditions...)
+	if d.TargetNamespace != "" {
+		builder.TargetNamespace(d.TargetNamespace)
+	}
+	if d.Managed {
+		builder.Managed()
+	}
+	if len(d.Manifests) != 0 {
+		builder.ManifestsLocation(manifests).Manifests(d.Manifests...)
+	}
+
+	return builder.Load()
+}
+
+func catalogActions(refs []ActionRef) ([]Action, error) {
+	actions := make([]Action, 0, len(refs))
+	for _, ref := range refs {
+		action, err := catalogAction(ref)
+		if err != nil {
+			return nil, err
+		}
+		actions = append(actions, action)
+	}
+
+	return actions, nil
+}
+
+// RemoveUndeclaredFeatures removes the features which have been declared in the ConfigMap and are not anymore, along
+// with the objects they created and the patches they applied, as listed in their FeatureTrackers. Nothing is removed
+// while the ConfigMap contains invalid definitions.
+func RemoveUndeclaredFeatures(ctx context.Context, cli client.Client, dsci *dsciv1.DSCInitialization, configMap *corev1.ConfigMap,
+	config *rest.Config,
+) error {
+	definitions, _, err := ParseDefinitions(configMap.Data)
+	if err != nil {
+		return err
+	}
+	declared := make(map[string]bool, len(definitions))
+	for _, definition := range definitions {
+		declared[featurev1.NewFeatureTracker(definition.Name, dsci.Spec.ApplicationsNamespace).Name] = true
+	}
+
+	trackers := &featurev1.FeatureTrackerList{}
+	if err := cli.List(ctx, trackers); err != nil {
+		return fmt.Errorf("failed listing feature trackers: %w", err)
+	}
+
+	var undeclared []string
+	for i := range trackers.Items {
+		tracker := &trackers.Items[i]
+		source := tracker.Spec.Source
+		if source.Type != featurev1.DeclaredType || source.Name != configMap.Name ||
+			tracker.Spec.AppNamespace != dsci.Spec.ApplicationsNamespace || declared[tracker.Name] {
+			continue
+		}
+		undeclared = append(undeclared, strings.TrimPrefix(tracker.Name, tracker.Spec.AppNamespace+"-"))
+	}
+	if len(undeclared) == 0 {
+		return nil
+	}
+
+	// Removed features only need their FeatureTrackers to be cleaned up
+	handler := &FeaturesHandler{
+		DSCInitializationSpec: &dsci.Spec,
+		source:                featurev1.Source{Type: featurev1.DeclaredType, Name: configMap.Name},
+		featuresProviders: []FeaturesProvider{func(handler *FeaturesHandler) error {
+			for _, name := range undeclared {
+				if err := CreateFeature(name).For(handler).UsingConfig(config).Load(); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		}},
+	}
+
+	return handler.Delete(ctx)
+}
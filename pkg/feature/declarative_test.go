@@ -0,0 +1,100 @@
+package feature_test
+
+import (
+	"io/fs"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Declaring features", func() {
+
+	const gatewayDefinition = `
+name: extra-gateway
+targetNamespace: istio-system
+managed: true
+dependsOn:
+- extra-namespace
+manifests:
+- gateway.tmpl.yaml
+preconditions:
+- name: EnsureOperatorIsInstalled
+  args: ["servicemeshoperator"]
+postconditions:
+- name: WaitForPodsToBeReady
+  args: ["istio-system"]
+`
+
+	It("should parse definitions sorted by key along with their manifests", func() {
+		definitions, manifests, err := feature.ParseDefinitions(map[string]string{
+			"gateway.feature.yaml":   gatewayDefinition,
+			"namespace.feature.yaml": "name: extra-namespace\nmanifests: [namespace.yaml]",
+			"gateway.tmpl.yaml":      "kind: Gateway",
+			"namespace.yaml":         "kind: Namespace",
+		})
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(definitions).To(Equal([]feature.Definition{
+			{
+				Name:            "extra-gateway",
+				TargetNamespace: "istio-system",
+				Managed:         true,
+				DependsOn:       []string{"extra-namespace"},
+				Manifests:       []string{"gateway.tmpl.yaml"},
+				PreConditions:   []feature.ActionRef{{Name: "EnsureOperatorIsInstalled", Args: []string{"servicemeshoperator"}}},
+				PostConditions:  []feature.ActionRef{{Name: "WaitForPodsToBeReady", Args: []string{"istio-system"}}},
+			},
+			{
+				Name:      "extra-namespace",
+				Manifests: []string{"namespace.yaml"},
+			},
+		}))
+
+		gateway, err := fs.ReadFile(manifests, "gateway.tmpl.yaml")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(gateway)).To(Equal("kind: Gateway"))
+		_, err = fs.ReadFile(manifests, "gateway.feature.yaml")
+		Expect(err).To(HaveOccurred())
+	})
+
+	DescribeTable("should report invalid definitions",
+		func(definition, expectedErr string) {
+			_, _, err := feature.ParseDefinitions(map[string]string{
+				"invalid.feature.yaml": definition,
+				"namespace.yaml":       "kind: Namespace",
+			})
+
+			Expect(err).To(MatchError(ContainSubstring("invalid feature definition invalid.feature.yaml")))
+			Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+		},
+		Entry("without name", "manifests: [namespace.yaml]", "name is required"),
+		Entry("with unknown fields", "name: invalid\nmanifest: [namespace.yaml]", `unknown field "manifest"`),
+		Entry("with missing manifests", "name: invalid\nmanifests: [gateway.yaml]", "manifest gateway.yaml is not part of the ConfigMap"),
+		Entry("with unknown actions", "name: invalid\npreconditions: [{name: EnsureNothing}]", `unknown action "EnsureNothing"`),
+		Entry("with wrong arguments", "name: invalid\ndata: [{name: WaitForPodsToBeReady}]",
+			"invalid arguments of action WaitForPodsToBeReady: expected 1 arguments, got 0"),
+		Entry("with the name of a feature of the operator", "name: mesh-control-plane-creation\nmanifests: [namespace.yaml]",
+			"name mesh-control-plane-creation is reserved for a feature of the operator"),
+		Entry("with undeclared dependencies", "name: invalid\ndependsOn: [extra-namespace]",
+			"dependency extra-namespace is not declared in the ConfigMap"),
+	)
+
+	It("should accept dependencies on features declared under any key of the ConfigMap", func() {
+		definitions, _, err := feature.ParseDefinitions(map[string]string{
+			"a-gateway.feature.yaml": "name: extra-gateway\ndependsOn: [extra-namespace]",
+			"namespace.feature.yaml": "name: extra-namespace\nmanifests: [namespace.yaml]",
+			"namespace.yaml":         "kind: Namespace",
+		})
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(definitions).To(HaveLen(2))
+	})
+
+	It("should list the actions of the catalog", func() {
+		Expect(feature.RegisteredActions()).To(ContainElements(
+			"CreateNamespaceIfNotExists", "EnsureOperatorIsInstalled", "WaitForPodsToBeReady", "WaitForResourceToBeCreated",
+		))
+	})
+})
@@ -0,0 +1,101 @@
+package feature
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+)
+
+// ApplyOrder sorts features so that every feature comes after the features it depends on, as declared with DependsOn.
+// Features keep the order they were loaded in otherwise, and dependencies on features which are not part of the given
+// list, e.g. loaded by another FeaturesHandler, are ignored.
+func ApplyOrder(features []*Feature) ([]*Feature, error) {
+	known := make(map[string]bool, len(features))
+	for _, f := range features {
+		if known[f.Name] {
+			return nil, fmt.Errorf("feature %s is defined more than once", f.Name)
+		}
+		known[f.Name] = true
+	}
+
+	ordered := make([]*Feature, 0, len(features))
+	done := make(map[string]bool, len(features))
+	for len(ordered) < len(features) {
+		progressed := false
+		for _, f := range features {
+			if done[f.Name] || !dependenciesDone(f, known, done) {
+				continue
+			}
+			ordered = append(ordered, f)
+			done[f.Name] = true
+			progressed = true
+		}
+
+		if !progressed {
+			var cycle []string
+			for _, f := range features {
+				if !done[f.Name] {
+					cycle = append(cycle, f.Name)
+				}
+			}
+			sort.Strings(cycle)
+
+			return nil, fmt.Errorf("dependency cycle detected between features: %s", strings.Join(cycle, ", "))
+		}
+	}
+
+	return ordered, nil
+}
+
+func dependenciesDone(f *Feature, known, done map[string]bool) bool {
+	for _, dependency := range f.Dependencies {
+		if known[dependency] && !done[dependency] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// failedDependency returns the first dependency of the feature which has not been applied, along with its error.
+func failedDependency(f *Feature, failed map[string]error) (string, error) {
+	for _, dependency := range f.Dependencies {
+		if err, found := failed[dependency]; found {
+			return dependency, err
+		}
+	}
+
+	return "", nil
+}
+
+// skip reports in the FeatureTracker that the feature is not applied because one of its dependencies has not been.
+// Features whose dependency waits for the cluster wait as well, otherwise they are reported as failed.
+func (f *Feature) skip(ctx context.Context, dependency string, dependencyErr error) error {
+	if !f.Enabled {
+		return nil
+	}
+
+	var skipErr error
+	if cluster.IsNotReady(dependencyErr) {
+		skipErr = cluster.NewNotReadyError("feature %s waits for its dependency %s", f.Name, dependency)
+	} else {
+		skipErr = &withConditionReasonError{
+			reason: featurev1.ConditionReason.DependencyFailed,
+			err:    fmt.Errorf("feature %s skipped as its dependency %s failed", f.Name, dependency),
+		}
+	}
+	f.Log.Info("skipping feature", "dependency", dependency, "reason", skipErr.Error())
+
+	if trackerErr := f.createFeatureTracker(ctx); trackerErr != nil {
+		return trackerErr
+	}
+	if _, reportErr := createFeatureTrackerStatusReporter(f).ReportCondition(ctx, skipErr); reportErr != nil {
+		return reportErr
+	}
+
+	return skipErr
+}
@@ -0,0 +1,61 @@
+package feature_test
+
+import (
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func newFeature(name string, dependencies ...string) *feature.Feature {
+	return &feature.Feature{Name: name, Dependencies: dependencies}
+}
+
+func featureNames(features []*feature.Feature) []string {
+	names := make([]string, 0, len(features))
+	for _, f := range features {
+		names = append(names, f.Name)
+	}
+
+	return names
+}
+
+var _ = Describe("Ordering features by their dependencies", func() {
+
+	It("should keep the loading order of independent features", func() {
+		features, err := feature.ApplyOrder([]*feature.Feature{
+			newFeature("mesh-shared-configmap"), newFeature("mesh-control-plane-creation"),
+		})
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(featureNames(features)).To(Equal([]string{"mesh-shared-configmap", "mesh-control-plane-creation"}))
+	})
+
+	It("should apply features after the features they depend on", func() {
+		features, err := feature.ApplyOrder([]*feature.Feature{
+			newFeature("serverless-serving-gateways", "serverless-serving-deployment"),
+			newFeature("mesh-metrics-collection", "mesh-control-plane-creation", "another-handler-feature"),
+			newFeature("serverless-serving-deployment", "mesh-control-plane-creation"),
+			newFeature("mesh-control-plane-creation"),
+		})
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(featureNames(features)).To(Equal([]string{
+			"mesh-control-plane-creation", "mesh-metrics-collection", "serverless-serving-deployment", "serverless-serving-gateways",
+		}))
+	})
+
+	It("should detect dependency cycles", func() {
+		_, err := feature.ApplyOrder([]*feature.Feature{
+			newFeature("independent"), newFeature("b", "a"), newFeature("a", "b"),
+		})
+
+		Expect(err).To(MatchError("dependency cycle detected between features: a, b"))
+	})
+
+	It("should refuse features defined more than once", func() {
+		_, err := feature.ApplyOrder([]*feature.Feature{newFeature("a"), newFeature("a")})
+
+		Expect(err).To(MatchError(ContainSubstring("feature a is defined more than once")))
+	})
+})
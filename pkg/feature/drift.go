@@ -0,0 +1,114 @@
+package feature
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+)
+
+// Objects created from the manifests of an applied feature are owned by its FeatureTracker, which records a hash of each
+// field set by the manifests in its status, so that changes made to the objects afterwards can be detected without
+// keeping their content. Changed objects of features managed by the operator are reverted by applying the feature again.
+
+// Drift lists the objects of an applied feature which have been changed or removed since it has been applied.
+type Drift struct {
+	// Managed tells whether the feature is managed by the operator, in which case the drift is reverted.
+	Managed bool
+	// Resources are the objects which differ from their applied state.
+	Resources []featurev1.TrackedResource
+	// Changes describe how each of the objects differs, e.g. "ConfigMap istio-system/mesh: data.key".
+	Changes []string
+}
+
+// DetectDrift compares the objects applied by the feature of the FeatureTracker with the fields recorded in its status,
+// considering only the fields set by the manifests. It returns nil when no applied object has been recorded.
+func DetectDrift(ctx context.Context, cli client.Client, tracker *featurev1.FeatureTracker) (*Drift, error) {
+	var drift *Drift
+	for _, resource := range tracker.Status.Resources {
+		if len(resource.AppliedFields) == 0 {
+			continue
+		}
+		if drift == nil {
+			drift = &Drift{Managed: true}
+		}
+		drift.Managed = drift.Managed && resource.Managed
+
+		live := &unstructured.Unstructured{}
+		live.SetGroupVersionKind(resource.GroupVersionKind())
+		err := cli.Get(ctx, client.ObjectKey{Namespace: resource.Namespace, Name: resource.Name}, live)
+		if client.IgnoreNotFound(err) != nil {
+			return nil, fmt.Errorf("failed to get object %s: %w", resource.String(), err)
+		}
+
+		var fields []string
+		if err != nil {
+			fields = []string{"removed"}
+		} else {
+			liveFields := appliedFields(live)
+			for field, hash := range resource.AppliedFields {
+				if liveFields[field] != hash {
+					fields = append(fields, field)
+				}
+			}
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		sort.Strings(fields)
+		drift.Resources = append(drift.Resources, resource)
+		drift.Changes = append(drift.Changes, resource.String()+": "+strings.Join(fields, ", "))
+	}
+
+	return drift, nil
+}
+
+// appliedFields returns the hashes of the values of the fields set in the object, keyed by their path. The number of
+// items of lists is recorded as well, and only labels and annotations of the metadata are considered, as the rest is
+// maintained by the API server.
+func appliedFields(obj *unstructured.Unstructured) map[string]string {
+	fields := map[string]string{}
+	addFieldHashes(nil, obj.Object, fields)
+
+	return fields
+}
+
+func addFieldHashes(path []string, value interface{}, fields map[string]string) {
+	joined := strings.Join(path, ".")
+	switch typedValue := value.(type) {
+	case map[string]interface{}:
+		for key, nested := range typedValue {
+			if (len(path) == 0 && key == "status") || (joined == "metadata" && key != "labels" && key != "annotations") {
+				continue
+			}
+			addFieldHashes(append(append([]string(nil), path...), key), nested, fields)
+		}
+	case []interface{}:
+		fields[joined] = valueHash(len(typedValue))
+		for i, item := range typedValue {
+			itemPath := append(append([]string(nil), path[:len(path)-1]...), fmt.Sprintf("%s[%d]", path[len(path)-1], i))
+			addFieldHashes(itemPath, item, fields)
+		}
+	default:
+		fields[joined] = valueHash(value)
+	}
+}
+
+// valueHash hashes the value regardless of the numeric types used by the JSON decoder and unstructured objects.
+func valueHash(value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		data = []byte(fmt.Sprint(value))
+	}
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:8])
+}
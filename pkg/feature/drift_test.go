@@ -0,0 +1,196 @@
+package feature_test
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Drift of feature objects", func() {
+
+	var ctx context.Context
+
+	configMap := func(name string, data map[string]string, objAnnotations map[string]string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion("v1")
+		obj.SetKind("ConfigMap")
+		obj.SetNamespace("istio-system")
+		obj.SetName(name)
+		obj.SetAnnotations(objAnnotations)
+		content := map[string]interface{}{}
+		for key, value := range data {
+			content[key] = value
+		}
+		Expect(unstructured.SetNestedField(obj.Object, content, "data")).To(Succeed())
+
+		return obj
+	}
+
+	appliedResource := func(obj *unstructured.Unstructured) featurev1.TrackedResource {
+		return featurev1.TrackedResource{
+			Version: "v1", Kind: obj.GetKind(), Namespace: obj.GetNamespace(), Name: obj.GetName(),
+			AppliedFields: feature.AppliedFields(obj),
+			Managed:       obj.GetAnnotations()[annotations.ManagedByODHOperator] == "true",
+		}
+	}
+
+	trackerOf := func(resources ...featurev1.TrackedResource) *featurev1.FeatureTracker {
+		tracker := featurev1.NewFeatureTracker("mesh-shared-configmap", "opendatahub")
+		tracker.Status.Resources = resources
+
+		return tracker
+	}
+
+	newClient := func(objs ...client.Object) client.Client {
+		scheme := runtime.NewScheme()
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+
+		return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	It("should not report drift when no applied object is recorded", func() {
+		tracker := trackerOf(
+			featurev1.TrackedResource{Version: "v1", Kind: "ConfigMap", Namespace: "istio-system", Name: "existing"},
+			featurev1.TrackedResource{Version: "v1", Kind: "ConfigMap", Namespace: "istio-system", Name: "patched", Patch: true},
+		)
+
+		drift, err := feature.DetectDrift(ctx, newClient(), tracker)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(drift).To(BeNil())
+	})
+
+	It("should not report objects as they have been applied", func() {
+		applied := configMap("mesh", map[string]string{"key": "value"}, nil)
+		live := applied.DeepCopy()
+		live.SetLabels(map[string]string{"added": "by-user"})
+		Expect(unstructured.SetNestedField(live.Object, "other", "data", "other")).To(Succeed())
+
+		drift, err := feature.DetectDrift(ctx, newClient(live), trackerOf(appliedResource(applied)))
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(drift).ToNot(BeNil())
+		Expect(drift.Resources).To(BeEmpty())
+	})
+
+	It("should report changed fields and removed objects", func() {
+		changed := configMap("mesh", map[string]string{"key": "value", "other": "value"}, nil)
+		live := changed.DeepCopy()
+		Expect(unstructured.SetNestedField(live.Object, "changed", "data", "other")).To(Succeed())
+		Expect(unstructured.SetNestedField(live.Object, "changed", "data", "key")).To(Succeed())
+		removed := configMap("removed", map[string]string{"key": "value"}, nil)
+
+		drift, err := feature.DetectDrift(ctx, newClient(live), trackerOf(appliedResource(changed), appliedResource(removed)))
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(drift.Managed).To(BeFalse())
+		Expect(drift.Changes).To(Equal([]string{
+			"ConfigMap istio-system/mesh: data.key, data.other",
+			"ConfigMap istio-system/removed: removed",
+		}))
+		Expect(drift.Resources).To(HaveLen(2))
+		Expect(drift.Resources[0].Name).To(Equal("mesh"))
+	})
+
+	It("should report changed number of list items", func() {
+		port := func(name string, number int64) interface{} {
+			return map[string]interface{}{"name": name, "port": number}
+		}
+		applied := &unstructured.Unstructured{}
+		applied.SetAPIVersion("v1")
+		applied.SetKind("Service")
+		applied.SetNamespace("istio-system")
+		applied.SetName("knative-local-gateway")
+		Expect(unstructured.SetNestedSlice(applied.Object, []interface{}{port("http2", 80)}, "spec", "ports")).To(Succeed())
+		live := applied.DeepCopy()
+		Expect(unstructured.SetNestedSlice(live.Object, []interface{}{port("http2", 80), port("https", 443)}, "spec", "ports")).To(Succeed())
+
+		drift, err := feature.DetectDrift(ctx, newClient(live), trackerOf(appliedResource(applied)))
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(drift.Changes).To(Equal([]string{"Service istio-system/knative-local-gateway: spec.ports"}))
+	})
+
+	It("should only record hashes of the applied fields", func() {
+		applied := configMap("mesh", map[string]string{"password": "secret-value"}, map[string]string{"note": "kept"})
+		applied.SetResourceVersion("42")
+
+		fields := feature.AppliedFields(applied)
+
+		Expect(fields).To(HaveKey("data.password"))
+		Expect(fields).To(HaveKey("metadata.annotations.note"))
+		Expect(fields).ToNot(HaveKey("metadata.resourceVersion"))
+		Expect(fields["data.password"]).ToNot(ContainSubstring("secret-value"))
+	})
+
+	It("should report objects marked as managed as such", func() {
+		applied := configMap("mesh", map[string]string{"key": "value"}, map[string]string{annotations.ManagedByODHOperator: "true"})
+
+		drift, err := feature.DetectDrift(ctx, newClient(), trackerOf(appliedResource(applied)))
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(drift.Managed).To(BeTrue())
+		Expect(drift.Changes).To(ConsistOf("ConfigMap istio-system/mesh: removed"))
+	})
+
+	Context("applying objects", func() {
+
+		owner := metav1.OwnerReference{APIVersion: "features.opendatahub.io/v1", Kind: "FeatureTracker", Name: "tracker", UID: types.UID("tracker-uid")}
+		ownedBy := func(obj metav1.Object) error {
+			obj.SetOwnerReferences([]metav1.OwnerReference{owner})
+
+			return nil
+		}
+
+		It("should only record the state of objects which are applied", func() {
+			existing := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "istio-system", Name: "existing", OwnerReferences: []metav1.OwnerReference{owner}},
+				Data:       map[string]string{"key": "changed-by-user"},
+			}
+			managed := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "istio-system", Name: "managed"},
+				Data:       map[string]string{"key": "changed-by-user"},
+			}
+			cli := newClient(existing, managed)
+			managedObj := configMap("managed", map[string]string{"key": "value"}, map[string]string{annotations.ManagedByODHOperator: "true"})
+			rendered := managedObj.DeepCopy()
+			rendered.SetOwnerReferences([]metav1.OwnerReference{owner})
+
+			tracked, err := feature.ApplyResources(ctx, cli, []*unstructured.Unstructured{
+				configMap("existing", map[string]string{"key": "value"}, nil),
+				managedObj,
+			}, cluster.MetaOptions(ownedBy))
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tracked).To(HaveLen(2))
+			Expect(tracked[0].Name).To(Equal("existing"))
+			Expect(tracked[0].AppliedFields).To(BeEmpty())
+			Expect(tracked[1].Name).To(Equal("managed"))
+			Expect(tracked[1].AppliedFields).To(Equal(feature.AppliedFields(rendered)))
+			Expect(tracked[1].Managed).To(BeTrue())
+
+			Expect(cli.Get(ctx, client.ObjectKeyFromObject(existing), existing)).To(Succeed())
+			Expect(existing.Data).To(HaveKeyWithValue("key", "changed-by-user"))
+			Expect(cli.Get(ctx, client.ObjectKeyFromObject(managed), managed)).To(Succeed())
+			Expect(managed.Data).To(HaveKeyWithValue("key", "value"))
+		})
+	})
+})
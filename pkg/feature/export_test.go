@@ -0,0 +1,11 @@
+package feature
+
+// Helpers exposing unexported functions to the tests of the package.
+
+var ApplyResources = applyResources
+
+var PatchResources = patchResources
+
+var RevertPatch = revertPatch
+
+var AppliedFields = appliedFields
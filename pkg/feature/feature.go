@@ -16,6 +16,7 @@ import (
 	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
 )
 
 type Feature struct {
@@ -24,10 +25,14 @@ type Feature struct {
 	Enabled bool
 	Managed bool
 	Tracker *featurev1.FeatureTracker
+	// Dependencies are the names of the features applied before this one, which is skipped when one of them fails.
+	Dependencies []string
 
 	Client client.Client
 
 	manifests []Manifest
+	// tracked are the objects created or patched while applying the feature
+	tracked []featurev1.TrackedResource
 
 	cleanups       []Action
 	resources      []Action
@@ -66,7 +71,11 @@ func (f *Feature) Apply(ctx context.Context) error {
 		return updateErr
 	}
 
+	f.tracked = nil
 	applyErr := f.applyFeature(ctx)
+	if applyErr == nil {
+		applyErr = pruneResources(ctx, f)
+	}
 	_, reportErr := createFeatureTrackerStatusReporter(f).ReportCondition(ctx, applyErr)
 
 	return multierror.Append(applyErr, reportErr).ErrorOrNil()
@@ -129,9 +138,9 @@ func (f *Feature) Cleanup(ctx context.Context) error {
 		return nil
 	}
 
-	// Ensure associated FeatureTracker instance has been removed as last one
-	// in the chain of cleanups.
-	f.addCleanup(removeFeatureTracker)
+	// Ensure objects created by the feature and its associated FeatureTracker instance
+	// have been removed as last ones in the chain of cleanups.
+	f.addCleanup(removeTrackedResources, removeFeatureTracker)
 
 	var cleanupErrors *multierror.Error
 	for _, cleanupFunc := range f.cleanups {
@@ -144,24 +153,77 @@ func (f *Feature) Cleanup(ctx context.Context) error {
 type applier func(ctx context.Context, objects []*unstructured.Unstructured) error
 
 func (f *Feature) createApplier(m Manifest) applier {
-	switch manifest := m.(type) {
-	case *templateManifest:
-		if manifest.patch {
-			return func(ctx context.Context, objects []*unstructured.Unstructured) error {
-				return patchResources(ctx, f.Client, objects)
+	if isPatch(m) {
+		return func(ctx context.Context, objects []*unstructured.Unstructured) error {
+			patched, err := patchResources(ctx, f.Client, objects)
+			if trackErr := f.track(patched); trackErr != nil {
+				return multierror.Append(err, trackErr).ErrorOrNil()
 			}
+
+			return err
 		}
+	}
+
+	return func(ctx context.Context, objects []*unstructured.Unstructured) error {
+		created, err := applyResources(ctx, f.Client, objects, OwnedBy(f))
+		if trackErr := f.track(created); trackErr != nil {
+			return multierror.Append(err, trackErr).ErrorOrNil()
+		}
+
+		return err
+	}
+}
+
+func isPatch(m Manifest) bool {
+	switch manifest := m.(type) {
+	case *templateManifest:
+		return manifest.patch
 	case *rawManifest:
-		if manifest.patch {
-			return func(ctx context.Context, objects []*unstructured.Unstructured) error {
-				return patchResources(ctx, f.Client, objects)
+		return manifest.patch
+	}
+
+	return false
+}
+
+// Render records manifests of the feature in the renderer instead of applying them to the cluster.
+// Data loaders are executed to fill in the templates, while preconditions, postconditions and resources
+// created programmatically are skipped, as they act on the cluster directly.
+func (f *Feature) Render(ctx context.Context, renderer *deploy.Renderer) error {
+	if !f.Enabled {
+		return nil
+	}
+
+	var multiErr *multierror.Error
+	for _, loader := range f.loaders {
+		multiErr = multierror.Append(multiErr, loader(ctx, f))
+	}
+	if dataLoadErr := multiErr.ErrorOrNil(); dataLoadErr != nil {
+		return dataLoadErr
+	}
+
+	for i := range f.manifests {
+		manifest := f.manifests[i]
+
+		objs, err := manifest.Process(f.Spec)
+		if err != nil {
+			return err
+		}
+
+		if f.Managed {
+			manifest.MarkAsManaged(objs)
+		}
+
+		if isPatch(manifest) {
+			if err := renderer.Patch(objs...); err != nil {
+				return err
 			}
+
+			continue
 		}
+		renderer.Record(objs...)
 	}
 
-	return func(ctx context.Context, objects []*unstructured.Unstructured) error {
-		return applyResources(ctx, f.Client, objects, OwnedBy(f))
-	}
+	return nil
 }
 
 func (f *Feature) addCleanup(cleanupFuncs ...Action) {
@@ -11,6 +11,7 @@ import (
 
 	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 )
 
 // withConditionReasonError is a wrapper around an error which provides a reason for a feature condition.
@@ -38,6 +39,9 @@ func (f *Feature) createFeatureTracker(ctx context.Context) error {
 		}
 	} else if err != nil {
 		return err
+	} else if tracker.Spec.Source.Type != f.Spec.Source.Type {
+		// features declared in ConfigMaps can not take over the ones built into the operator, and conversely
+		return fmt.Errorf("feature %s is already applied by %s %s", f.Name, tracker.Spec.Source.Type, tracker.Spec.Source.Name)
 	}
 
 	if gvkErr := f.ensureGVKSet(tracker); gvkErr != nil {
@@ -97,22 +101,38 @@ func (f *Feature) ensureGVKSet(obj runtime.Object) error {
 
 func createFeatureTrackerStatusReporter(f *Feature) *status.Reporter[*featurev1.FeatureTracker] {
 	return status.NewStatusReporter(f.Client, f.Tracker, func(err error) status.SaveStatusFunc[*featurev1.FeatureTracker] {
-		updatedCondition := func(saved *featurev1.FeatureTracker) {
-			status.SetCompleteCondition(&saved.Status.Conditions, string(featurev1.ConditionReason.FeatureCreated), fmt.Sprintf("Applied feature [%s] successfully", f.Name))
-			saved.Status.Phase = status.PhaseReady
+		updateCondition := featureTrackerCondition(f, err)
+
+		return func(saved *featurev1.FeatureTracker) {
+			updateCondition(saved)
+			saved.Status.Resources = f.trackedResources(saved.Status.Resources, err == nil)
 		}
-		if err != nil {
-			reason := featurev1.ConditionReason.FailedApplying // generic reason when error is not related to any specific step of the feature apply
-			var conditionErr *withConditionReasonError
-			if errors.As(err, &conditionErr) {
-				reason = conditionErr.reason
-			}
-			updatedCondition = func(saved *featurev1.FeatureTracker) {
-				status.SetErrorCondition(&saved.Status.Conditions, string(reason), fmt.Sprintf("Failed applying [%s]: %+v", f.Name, err))
-				saved.Status.Phase = status.PhaseError
-			}
+	})
+}
+
+// featureTrackerCondition reports the outcome of applying the feature in the conditions of its FeatureTracker.
+func featureTrackerCondition(f *Feature, err error) status.SaveStatusFunc[*featurev1.FeatureTracker] {
+	updatedCondition := func(saved *featurev1.FeatureTracker) {
+		status.SetCompleteCondition(&saved.Status.Conditions, string(featurev1.ConditionReason.FeatureCreated), fmt.Sprintf("Applied feature [%s] successfully", f.Name))
+		saved.Status.Phase = status.PhaseReady
+	}
+	if notReady, waiting := cluster.AsNotReady(err); waiting {
+		return func(saved *featurev1.FeatureTracker) {
+			status.SetProgressingCondition(&saved.Status.Conditions, string(featurev1.ConditionReason.Waiting), fmt.Sprintf("Waiting to apply [%s]: %s", f.Name, notReady.Message))
+			saved.Status.Phase = status.PhaseProgressing
 		}
+	}
+	if err != nil {
+		reason := featurev1.ConditionReason.FailedApplying // generic reason when error is not related to any specific step of the feature apply
+		var conditionErr *withConditionReasonError
+		if errors.As(err, &conditionErr) {
+			reason = conditionErr.reason
+		}
+		updatedCondition = func(saved *featurev1.FeatureTracker) {
+			status.SetErrorCondition(&saved.Status.Conditions, string(reason), fmt.Sprintf("Failed applying [%s]: %+v", f.Name, err))
+			saved.Status.Phase = status.PhaseError
+		}
+	}
 
-		return updatedCondition
-	})
+	return updatedCondition
 }
@@ -6,11 +6,14 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/go-multierror"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
 	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
 )
 
 type featureHandler interface {
@@ -26,6 +29,8 @@ type FeaturesHandler struct {
 	source            featurev1.Source
 	features          []*Feature
 	featuresProviders []FeaturesProvider
+	// client is used by features loaded in render mode instead of connecting to the cluster.
+	client client.Client
 }
 
 // EmptyFeaturesHandler is noop handler so that we can avoid nil checks in the code and safely call Apply/Delete methods.
@@ -87,15 +92,47 @@ func ComponentFeaturesHandler(componentName string, spec *dsciv1.DSCInitializati
 }
 
 func (fh *FeaturesHandler) Apply(ctx context.Context) error {
+	renderer := fh.useRenderer(ctx)
+	plan := deploy.PlanFrom(ctx)
 	for _, featuresProvider := range fh.featuresProviders {
 		if err := featuresProvider(fh); err != nil {
+			if plan != nil && plan.RecordNotReady(fh.source.Name, err) {
+				return nil
+			}
+
 			return fmt.Errorf("apply phase failed when applying features: %w", err)
 		}
 	}
 
+	if renderer != nil {
+		return fh.render(ctx, renderer)
+	}
+
+	if plan != nil {
+		return fh.plan(ctx, plan, deploy.PlanActionUpdate)
+	}
+
+	features, err := ApplyOrder(fh.features)
+	if err != nil {
+		return fmt.Errorf("apply phase failed when ordering features: %w", err)
+	}
+
+	// Features whose dependencies have not been applied are skipped, which their dependents are in turn
 	var applyErrors *multierror.Error
-	for _, f := range fh.features {
-		applyErrors = multierror.Append(applyErrors, f.Apply(ctx))
+	failed := make(map[string]error)
+	for _, f := range features {
+		if dependency, dependencyErr := failedDependency(f, failed); dependency != "" {
+			if skipErr := f.skip(ctx, dependency, dependencyErr); skipErr != nil {
+				failed[f.Name] = skipErr
+				applyErrors = multierror.Append(applyErrors, skipErr)
+			}
+
+			continue
+		}
+		if applyErr := f.Apply(ctx); applyErr != nil {
+			failed[f.Name] = applyErr
+			applyErrors = multierror.Append(applyErrors, applyErr)
+		}
 	}
 
 	return applyErrors.ErrorOrNil()
@@ -103,19 +140,100 @@ func (fh *FeaturesHandler) Apply(ctx context.Context) error {
 
 // Delete executes registered clean-up tasks in the opposite order they were initiated (following a stack structure).
 // For instance, this allows for the undoing patches before its deletion.
-// This approach assumes that Features are either instantiated in the correct sequence
-// or are self-contained.
+// Features are initiated after the features they depend on, the others being expected to be either instantiated
+// in the correct sequence or self-contained.
 func (fh *FeaturesHandler) Delete(ctx context.Context) error {
+	renderer := fh.useRenderer(ctx)
+	plan := deploy.PlanFrom(ctx)
 	for _, featuresProvider := range fh.featuresProviders {
 		if err := featuresProvider(fh); err != nil {
+			if plan != nil && plan.RecordNotReady(fh.source.Name, err) {
+				return nil
+			}
+
 			return fmt.Errorf("delete phase failed when wiring Feature instances: %w", err)
 		}
 	}
 
+	// Removed features have nothing to render
+	if renderer != nil {
+		return nil
+	}
+
+	if plan != nil {
+		return fh.plan(ctx, plan, deploy.PlanActionDelete)
+	}
+
+	features, err := ApplyOrder(fh.features)
+	if err != nil {
+		return fmt.Errorf("delete phase failed when ordering features: %w", err)
+	}
+
 	var cleanupErrors *multierror.Error
-	for i := len(fh.features) - 1; i >= 0; i-- {
-		cleanupErrors = multierror.Append(cleanupErrors, fh.features[i].Cleanup(ctx))
+	for i := len(features) - 1; i >= 0; i-- {
+		cleanupErrors = multierror.Append(cleanupErrors, features[i].Cleanup(ctx))
 	}
 
 	return cleanupErrors.ErrorOrNil()
 }
+
+// plan records features which would be applied or removed, without executing them.
+// Features are not rendered in plan mode, as their data loaders and conditions require access to the live cluster,
+// therefore each of them is reported as a change of its FeatureTracker. Providers waiting for the cluster before wiring
+// the features are reported as such, instead of failing the plan.
+func (fh *FeaturesHandler) plan(ctx context.Context, plan *deploy.Plan, action deploy.PlanAction) error {
+	for _, f := range fh.features {
+		if !f.Enabled {
+			continue
+		}
+
+		featureAction := action
+		tracker := featurev1.NewFeatureTracker(f.Name, fh.ApplicationsNamespace)
+		err := f.Client.Get(ctx, client.ObjectKeyFromObject(tracker), tracker)
+		switch {
+		case k8serr.IsNotFound(err):
+			if action == deploy.PlanActionDelete {
+				continue
+			}
+			featureAction = deploy.PlanActionCreate
+		case err != nil:
+			return fmt.Errorf("failed getting feature tracker of feature %s: %w", f.Name, err)
+		}
+
+		plan.Record(deploy.PlannedChange{
+			Action:    featureAction,
+			Component: fh.source.Name,
+			Object: corev1.ObjectReference{
+				APIVersion: featurev1.GroupVersion.String(),
+				Kind:       "FeatureTracker",
+				Name:       tracker.Name,
+			},
+			Message: "feature " + f.Name + " is not rendered in plan mode",
+		})
+	}
+
+	return nil
+}
+
+// useRenderer returns the renderer attached to the context, if any, and makes features loaded afterwards
+// read the cluster state through its client.
+func (fh *FeaturesHandler) useRenderer(ctx context.Context) *deploy.Renderer {
+	renderer := deploy.RendererFrom(ctx)
+	if renderer != nil && len(fh.featuresProviders) != 0 {
+		fh.client = renderer.Client()
+	}
+
+	return renderer
+}
+
+// render records manifests of all enabled features in the renderer, instead of applying them.
+func (fh *FeaturesHandler) render(ctx context.Context, renderer *deploy.Renderer) error {
+	var renderErrors *multierror.Error
+	for _, f := range fh.features {
+		if err := f.Render(ctx, renderer); err != nil {
+			renderErrors = multierror.Append(renderErrors, fmt.Errorf("failed rendering feature %s: %w", f.Name, err))
+		}
+	}
+
+	return renderErrors.ErrorOrNil()
+}
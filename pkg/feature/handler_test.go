@@ -0,0 +1,64 @@
+package feature_test
+
+import (
+	"context"
+	"errors"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Planning features", func() {
+
+	var (
+		plan *deploy.Plan
+		ctx  context.Context
+	)
+
+	BeforeEach(func() {
+		plan = deploy.NewPlan()
+		ctx = deploy.WithPlan(context.Background(), plan)
+	})
+
+	providerFailingWith := func(err error) feature.FeaturesProvider {
+		return func(_ *feature.FeaturesHandler) error {
+			return err
+		}
+	}
+
+	It("should record providers waiting for the cluster as informational changes", func() {
+		handler := feature.ComponentFeaturesHandler("kserve", &dsciv1.DSCInitializationSpec{},
+			providerFailingWith(cluster.NewNotReadyError("waiting for KnativeServing to be created in namespace %s", "knative-serving")))
+
+		Expect(handler.Apply(ctx)).To(Succeed())
+		Expect(handler.Delete(ctx)).To(Succeed())
+		Expect(plan.Changes()).To(HaveLen(2))
+		for _, change := range plan.Changes() {
+			Expect(change.Action).To(Equal(deploy.PlanActionWait))
+			Expect(change.Component).To(Equal("kserve"))
+			Expect(change.Message).To(Equal("waiting for KnativeServing to be created in namespace knative-serving"))
+		}
+	})
+
+	It("should fail on other errors of providers", func() {
+		providerErr := errors.New("serverless operator is not installed")
+		handler := feature.ComponentFeaturesHandler("kserve", &dsciv1.DSCInitializationSpec{}, providerFailingWith(providerErr))
+
+		Expect(handler.Apply(ctx)).To(MatchError(providerErr))
+		Expect(plan.Changes()).To(BeEmpty())
+	})
+
+	It("should fail when waiting for the cluster outside of plan mode", func() {
+		handler := feature.ComponentFeaturesHandler("kserve", &dsciv1.DSCInitializationSpec{},
+			providerFailingWith(cluster.NewNotReadyError("waiting for pods")))
+
+		err := handler.Apply(context.Background())
+
+		Expect(cluster.IsNotReady(err)).To(BeTrue())
+	})
+})
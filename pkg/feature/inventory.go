@@ -0,0 +1,139 @@
+package feature
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+)
+
+// Objects created and patched by a feature are listed in the status of its FeatureTracker. Created objects which are
+// not part of the feature anymore are removed when it is applied, and all of them when it is removed, instead of only
+// relying on the garbage collection of the objects owned by the FeatureTracker.
+
+// track records objects created or patched while applying the feature. Patches reversing the changes made to objects
+// which had already been patched keep the fields as they were before the first patch, along with the fields set by
+// previous patches.
+func (f *Feature) track(resources []featurev1.TrackedResource) error {
+	for _, resource := range resources {
+		if containsResource(f.tracked, resource) {
+			continue
+		}
+		if resource.Patch && f.Tracker != nil {
+			previous := findResource(f.Tracker.Status.Resources, resource)
+			if previous != nil {
+				reverse, err := mergeRecordedFields(resource.ReversePatch, previous.ReversePatch)
+				if err != nil {
+					return err
+				}
+				patched, err := mergeRecordedFields(previous.PatchedFields, resource.PatchedFields)
+				if err != nil {
+					return err
+				}
+				resource.ReversePatch, resource.PatchedFields = reverse, patched
+			}
+		}
+		f.tracked = append(f.tracked, resource)
+	}
+
+	return nil
+}
+
+// trackedResources returns the objects to list in the status of the FeatureTracker. Objects tracked previously are
+// kept when the feature has not been applied completely, as they might still exist.
+func (f *Feature) trackedResources(previous []featurev1.TrackedResource, complete bool) []featurev1.TrackedResource {
+	if complete {
+		return f.tracked
+	}
+
+	resources := append([]featurev1.TrackedResource(nil), f.tracked...)
+	for _, resource := range previous {
+		if !containsResource(resources, resource) {
+			resources = append(resources, resource)
+		}
+	}
+
+	return resources
+}
+
+// pruneResources removes the objects created by a previous application of the feature which it does not create anymore,
+// and reverts the patches it does not apply anymore.
+func pruneResources(ctx context.Context, f *Feature) error {
+	var pruneErrors *multierror.Error
+	for _, resource := range f.Tracker.Status.Resources {
+		if containsResource(f.tracked, resource) {
+			continue
+		}
+		if resource.Patch {
+			pruneErrors = multierror.Append(pruneErrors, revertPatch(ctx, f, resource))
+
+			continue
+		}
+		pruneErrors = multierror.Append(pruneErrors, deleteTrackedResource(ctx, f, resource))
+	}
+
+	return pruneErrors.ErrorOrNil()
+}
+
+// removeTrackedResources removes the objects created by the feature and reverts its patches, which are listed in its
+// FeatureTracker.
+func removeTrackedResources(ctx context.Context, f *Feature) error {
+	if err := getFeatureTrackerIfAbsent(ctx, f); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	var removeErrors *multierror.Error
+	for _, resource := range f.Tracker.Status.Resources {
+		if resource.Patch {
+			removeErrors = multierror.Append(removeErrors, revertPatch(ctx, f, resource))
+
+			continue
+		}
+		removeErrors = multierror.Append(removeErrors, deleteTrackedResource(ctx, f, resource))
+	}
+
+	return removeErrors.ErrorOrNil()
+}
+
+// deleteTrackedResource deletes the object if it is still owned by the FeatureTracker of the feature, leaving objects
+// which have been taken over by users or other features.
+func deleteTrackedResource(ctx context.Context, f *Feature, resource featurev1.TrackedResource) error {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(resource.GroupVersionKind())
+	if err := f.Client.Get(ctx, client.ObjectKey{Namespace: resource.Namespace, Name: resource.Name}, obj); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	for _, ref := range obj.GetOwnerReferences() {
+		if ref.UID == f.Tracker.UID {
+			f.Log.Info("removing object of the feature", "object", resource.String())
+			if err := f.Client.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+				return fmt.Errorf("failed to remove %s: %w", resource.String(), err)
+			}
+
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func containsResource(resources []featurev1.TrackedResource, resource featurev1.TrackedResource) bool {
+	return findResource(resources, resource) != nil
+}
+
+func findResource(resources []featurev1.TrackedResource, resource featurev1.TrackedResource) *featurev1.TrackedResource {
+	for i := range resources {
+		r := &resources[i]
+		if r.GroupVersionKind().GroupKind() == resource.GroupVersionKind().GroupKind() &&
+			r.Namespace == resource.Namespace && r.Name == resource.Name && r.Patch == resource.Patch {
+			return r
+		}
+	}
+
+	return nil
+}
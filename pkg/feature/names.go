@@ -0,0 +1,25 @@
+package feature
+
+// Names of the features built into the operator, which features declared in ConfigMaps can not use.
+const (
+	MeshControlPlaneCreation          = "mesh-control-plane-creation"
+	MeshMetricsCollection             = "mesh-metrics-collection"
+	MeshSharedConfigMap               = "mesh-shared-configmap"
+	MeshControlPlaneExternalAuthz     = "mesh-control-plane-external-authz"
+	KserveExternalAuthz               = "kserve-external-authz"
+	KserveTemporaryFixes              = "kserve-temporary-fixes"
+	ServerlessServingDeployment       = "serverless-serving-deployment"
+	ServerlessNetIstioSecretFiltering = "serverless-net-istio-secret-filtering"
+	ServerlessServingGateways         = "serverless-serving-gateways"
+)
+
+func builtInFeature(name string) bool {
+	switch name {
+	case MeshControlPlaneCreation, MeshMetricsCollection, MeshSharedConfigMap, MeshControlPlaneExternalAuthz,
+		KserveExternalAuthz, KserveTemporaryFixes,
+		ServerlessServingDeployment, ServerlessNetIstioSecretFiltering, ServerlessServingGateways:
+		return true
+	}
+
+	return false
+}
@@ -21,61 +21,109 @@ import (
 	k8stypes "k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/annotations"
 )
 
 const (
 	YamlSeparator = "(?m)^---[ \t]*$"
+	// legacyFieldManager is the field manager the API server recorded for objects created and updated by previous versions of the operator.
+	legacyFieldManager = "manager"
 )
 
-func applyResources(ctx context.Context, cli client.Client, objects []*unstructured.Unstructured, metaOptions ...cluster.MetaOptions) error {
+// applyResources creates or updates the given objects, returning references to the ones which are part of the feature,
+// i.e. all but existing objects the feature does not manage. Objects which have been applied are recorded as such.
+func applyResources(ctx context.Context, cli client.Client, objects []*unstructured.Unstructured,
+	metaOptions ...cluster.MetaOptions,
+) ([]featurev1.TrackedResource, error) {
+	var tracked []featurev1.TrackedResource
 	for _, object := range objects {
 		for _, opt := range metaOptions {
 			if err := opt(object); err != nil {
-				return err
+				return tracked, err
 			}
 		}
 
 		name := object.GetName()
 		namespace := object.GetNamespace()
 
-		err := cli.Get(ctx, k8stypes.NamespacedName{Name: name, Namespace: namespace}, object.DeepCopy())
+		found := object.DeepCopy()
+		err := cli.Get(ctx, k8stypes.NamespacedName{Name: name, Namespace: namespace}, found)
 		if client.IgnoreNotFound(err) != nil {
-			return fmt.Errorf("failed to get object %s/%s: %w", namespace, name, err)
+			return tracked, fmt.Errorf("failed to get object %s/%s: %w", namespace, name, err)
 		}
 
-		if err != nil {
-			// object does not exist and should be created
-			if createErr := cli.Create(ctx, object); client.IgnoreAlreadyExists(createErr) != nil {
-				return fmt.Errorf("failed to create object %s/%s: %w", namespace, name, createErr)
-			}
-		}
 		// object exists, check if it is managed
 		isManaged, isAnnotated := object.GetAnnotations()[annotations.ManagedByODHOperator]
-		if isAnnotated && isManaged == "true" {
-			// update the object since we manage it
-			if updateErr := cli.Update(ctx, object); updateErr != nil {
-				return fmt.Errorf("failed to update object %s/%s: %w", namespace, name, updateErr)
+		if err == nil && (!isAnnotated || isManaged != "true") {
+			// object exists and is not manged, skip reconcile allowing users to tweak it
+			if sameOwner(found, object) {
+				tracked = append(tracked, trackedResource(object, false))
 			}
+
+			continue
+		}
+
+		// object does not exist or is managed by the operator, apply it, recording the fields it sets as applied
+		resource := trackedResource(object, false)
+		resource.AppliedFields = appliedFields(object)
+		resource.Managed = isManaged == "true"
+		if applyErr := cluster.Apply(ctx, cli, object, cluster.TakeOverFrom(legacyFieldManager)); applyErr != nil {
+			return tracked, fmt.Errorf("failed to apply object %s/%s: %w", namespace, name, applyErr)
 		}
-		// object exists and is not manged, skip reconcile allowing users to tweak it
+		tracked = append(tracked, resource)
 	}
-	return nil
+	return tracked, nil
 }
 
-func patchResources(ctx context.Context, cli client.Client, patches []*unstructured.Unstructured) error {
+// patchResources merges the given patches into existing objects, returning references to the patched objects along
+// with the patches reversing the changes. Patches are merge patches, see cluster.MergePatch.
+func patchResources(ctx context.Context, cli client.Client, patches []*unstructured.Unstructured) ([]featurev1.TrackedResource, error) {
+	var tracked []featurev1.TrackedResource
 	for _, patch := range patches {
-		// Convert the individual resource patch to JSON
-		patchAsJSON, err := patch.MarshalJSON()
-		if err != nil {
-			return fmt.Errorf("error converting yaml to json: %w", err)
+		ref := trackedResource(patch, true)
+
+		found := &unstructured.Unstructured{}
+		found.SetGroupVersionKind(patch.GroupVersionKind())
+		if err := cli.Get(ctx, client.ObjectKeyFromObject(patch), found); err != nil {
+			return tracked, fmt.Errorf("failed getting resource to patch: %w", err)
+		}
+		if err := recordPatch(&ref, found.Object, patch.Object); err != nil {
+			return tracked, err
 		}
 
-		if err = cli.Patch(ctx, patch, client.RawPatch(k8stypes.MergePatchType, patchAsJSON)); err != nil {
-			return fmt.Errorf("failed patching resource: %w", err)
+		if err := cluster.MergePatch(ctx, cli, found, patch, cluster.TakeOverFrom(legacyFieldManager)); err != nil {
+			return tracked, fmt.Errorf("failed patching resource: %w", err)
 		}
+		tracked = append(tracked, ref)
 	}
 
-	return nil
+	return tracked, nil
+}
+
+// sameOwner tells whether the existing object has been created with one of the owners of the applied object.
+func sameOwner(found, object *unstructured.Unstructured) bool {
+	for _, ref := range object.GetOwnerReferences() {
+		for _, foundRef := range found.GetOwnerReferences() {
+			if ref.UID != "" && ref.UID == foundRef.UID {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func trackedResource(obj *unstructured.Unstructured, patch bool) featurev1.TrackedResource {
+	gvk := obj.GroupVersionKind()
+
+	return featurev1.TrackedResource{
+		Group:     gvk.Group,
+		Version:   gvk.Version,
+		Kind:      gvk.Kind,
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+		Patch:     patch,
+	}
 }
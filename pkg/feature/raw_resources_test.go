@@ -0,0 +1,91 @@
+package feature_test
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Patching objects", func() {
+
+	var ctx context.Context
+
+	controlPlane := func(spec map[string]interface{}) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(gvk.ServiceMeshControlPlane)
+		obj.SetNamespace("istio-system")
+		obj.SetName("data-science-smcp")
+		obj.Object["spec"] = spec
+
+		return obj
+	}
+
+	managedBy := func(obj *unstructured.Unstructured, manager, fields string) *unstructured.Unstructured {
+		obj.SetManagedFields([]metav1.ManagedFieldsEntry{{
+			Manager:    manager,
+			Operation:  metav1.ManagedFieldsOperationUpdate,
+			FieldsType: "FieldsV1",
+			FieldsV1:   &metav1.FieldsV1{Raw: []byte(fields)},
+		}})
+
+		return obj
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	It("should report fields replaced by the patch which are managed by others as conflicts", func() {
+		// given
+		existing := managedBy(controlPlane(map[string]interface{}{"version": "v2.4", "tracing": map[string]interface{}{"type": "None"}}),
+			"kubectl-edit", `{"f:spec":{"f:version":{},"f:tracing":{"f:type":{}}}}`)
+		cli := fake.NewClientBuilder().WithScheme(runtime.NewScheme()).WithObjects(existing).Build()
+		conflicts := cluster.NewConflicts()
+
+		// when
+		_, err := feature.PatchResources(cluster.WithConflicts(ctx, conflicts), cli, []*unstructured.Unstructured{
+			controlPlane(map[string]interface{}{"version": "v2.5", "tracing": map[string]interface{}{"type": "None", "sampling": int64(100)}}),
+		})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(conflicts.List()).To(HaveLen(1))
+		Expect(conflicts.List()[0].Fields).To(Equal([]string{".spec.version"}))
+		Expect(conflicts.List()[0].Managers).To(Equal([]string{"kubectl-edit"}))
+		Expect(conflicts.List()[0].Resolution).To(Equal(cluster.ConflictForced))
+
+		patched := controlPlane(nil)
+		Expect(cli.Get(ctx, client.ObjectKeyFromObject(patched), patched)).To(Succeed())
+		Expect(patched.Object["spec"]).To(Equal(map[string]interface{}{
+			"version": "v2.5",
+			"tracing": map[string]interface{}{"type": "None", "sampling": int64(100)},
+		}))
+	})
+
+	It("should not report fields managed by the operator or previous versions of it", func() {
+		// given
+		existing := managedBy(controlPlane(map[string]interface{}{"version": "v2.4"}), "manager", `{"f:spec":{"f:version":{}}}`)
+		cli := fake.NewClientBuilder().WithScheme(runtime.NewScheme()).WithObjects(existing).Build()
+		conflicts := cluster.NewConflicts()
+
+		// when
+		_, err := feature.PatchResources(cluster.WithConflicts(ctx, conflicts), cli, []*unstructured.Unstructured{
+			controlPlane(map[string]interface{}{"version": "v2.5"}),
+		})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(conflicts.List()).To(BeEmpty())
+	})
+})
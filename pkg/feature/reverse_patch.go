@@ -0,0 +1,319 @@
+package feature
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+)
+
+// Fields changed by patch manifests are recorded before the patch is applied, as a JSON merge patch restoring them,
+// which is kept in the status of the FeatureTracker along with the patched fields. The patch is reverted when the
+// feature is removed, or when the patch is not part of the feature anymore, so patch manifests do not need dedicated
+// cleanup functions. Only the changes made by the patch are reverted, so that the ones made since by others are kept.
+
+// recordPatch records in the tracked resource the fields of the original object changed by the given merge patch,
+// as the patch restoring them, and the fields set by the patch. Fields added by the patch are recorded as null.
+func recordPatch(resource *featurev1.TrackedResource, original, patch map[string]interface{}) error {
+	patched := withoutIdentity(patch)
+	reverse := reverseFields(original, patched)
+	if len(reverse) == 0 {
+		return nil
+	}
+
+	reverseJSON, err := json.Marshal(reverse)
+	if err != nil {
+		return fmt.Errorf("failed to record reverse patch: %w", err)
+	}
+	patchedJSON, err := json.Marshal(patched)
+	if err != nil {
+		return fmt.Errorf("failed to record patched fields: %w", err)
+	}
+	resource.ReversePatch = string(reverseJSON)
+	resource.PatchedFields = string(patchedJSON)
+
+	return nil
+}
+
+func reverseFields(original, patch map[string]interface{}) map[string]interface{} {
+	reverse := map[string]interface{}{}
+	for field, patchValue := range patch {
+		originalValue, exists := original[field]
+		patchFields, patchIsMap := patchValue.(map[string]interface{})
+		originalFields, originalIsMap := originalValue.(map[string]interface{})
+
+		switch {
+		case !exists && patchValue == nil:
+			// removing an absent field does not change the object
+		case !exists:
+			reverse[field] = nil
+		case patchIsMap && originalIsMap:
+			if nested := reverseFields(originalFields, patchFields); len(nested) != 0 {
+				reverse[field] = nested
+			}
+		case !reflect.DeepEqual(originalValue, patchValue):
+			reverse[field] = originalValue
+		}
+	}
+
+	return reverse
+}
+
+// withoutIdentity removes the fields identifying the patched object, which are not changed by the patch.
+func withoutIdentity(patch map[string]interface{}) map[string]interface{} {
+	fields := runtime.DeepCopyJSON(patch)
+	delete(fields, "apiVersion")
+	delete(fields, "kind")
+	if metadata, ok := fields["metadata"].(map[string]interface{}); ok {
+		delete(metadata, "name")
+		delete(metadata, "namespace")
+		if len(metadata) == 0 {
+			delete(fields, "metadata")
+		}
+	}
+
+	return fields
+}
+
+// mergeRecordedFields combines fields recorded in JSON with the given overrides, e.g. the reverse patch of a patch
+// applied again with the one recorded previously, which holds the values of the fields before they have been patched
+// the first time.
+func mergeRecordedFields(fields, overrides string) (string, error) {
+	if fields == "" {
+		return overrides, nil
+	}
+	if overrides == "" {
+		return fields, nil
+	}
+
+	recorded := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(fields), &recorded); err != nil {
+		return "", fmt.Errorf("failed to read recorded fields: %w", err)
+	}
+	overriding := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(overrides), &overriding); err != nil {
+		return "", fmt.Errorf("failed to read recorded fields: %w", err)
+	}
+
+	merged, err := json.Marshal(mergeFields(recorded, overriding))
+	if err != nil {
+		return "", fmt.Errorf("failed to record fields: %w", err)
+	}
+
+	return string(merged), nil
+}
+
+// mergeFields overrides fields with the given ones, merging nested fields.
+func mergeFields(fields, overrides map[string]interface{}) map[string]interface{} {
+	for field, override := range overrides {
+		overrideFields, overrideIsMap := override.(map[string]interface{})
+		existingFields, existingIsMap := fields[field].(map[string]interface{})
+		if overrideIsMap && existingIsMap {
+			fields[field] = mergeFields(existingFields, overrideFields)
+
+			continue
+		}
+		fields[field] = override
+	}
+
+	return fields
+}
+
+// revertPatch restores the fields of an object patched by the feature which still hold the patched values. Objects
+// patched before reverse patches were recorded are kept as they are.
+func revertPatch(ctx context.Context, f *Feature, resource featurev1.TrackedResource) error {
+	if resource.ReversePatch == "" || resource.PatchedFields == "" {
+		f.Log.Info("keeping patched object", "object", resource.String())
+
+		return nil
+	}
+
+	reverse := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(resource.ReversePatch), &reverse); err != nil {
+		return fmt.Errorf("failed to read reverse patch of %s: %w", resource.String(), err)
+	}
+	patched := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(resource.PatchedFields), &patched); err != nil {
+		return fmt.Errorf("failed to read patched fields of %s: %w", resource.String(), err)
+	}
+
+	f.Log.Info("reverting patch of object", "object", resource.String())
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(resource.GroupVersionKind())
+		if err := f.Client.Get(ctx, client.ObjectKey{Namespace: resource.Namespace, Name: resource.Name}, obj); err != nil {
+			return err
+		}
+
+		reverted := obj.DeepCopy()
+		revertFields(reverted.Object, patched, reverse)
+		if reflect.DeepEqual(reverted.Object, obj.Object) {
+			return nil
+		}
+
+		return f.Client.Update(ctx, reverted, client.FieldOwner(cluster.FieldManager))
+	})
+	if client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to revert patch of %s: %w", resource.String(), err)
+	}
+
+	return nil
+}
+
+// revertFields restores the fields of the object recorded in the reverse patch, as long as they hold the patched values.
+func revertFields(obj, patched, reverse map[string]interface{}) {
+	for field, original := range reverse {
+		patchedValue, isPatched := patched[field]
+		value, exists := obj[field]
+		switch {
+		case !isPatched:
+			// not changed by the patch
+		case patchedValue == nil:
+			// removed by the patch, restored unless it has been set again since
+			if !exists {
+				obj[field] = original
+			}
+		case !exists:
+			// removed since
+		case original == nil:
+			removeFields(obj, field, patchedValue)
+		default:
+			obj[field] = revertValue(value, patchedValue, original)
+		}
+	}
+}
+
+func revertValue(value, patched, original interface{}) interface{} {
+	fields, isMap := value.(map[string]interface{})
+	patchedFields, patchedIsMap := patched.(map[string]interface{})
+	originalFields, originalIsMap := original.(map[string]interface{})
+	if isMap && patchedIsMap && originalIsMap {
+		revertFields(fields, patchedFields, originalFields)
+
+		return fields
+	}
+
+	items, isList := value.([]interface{})
+	patchedItems, patchedIsList := patched.([]interface{})
+	originalItems, originalIsList := original.([]interface{})
+	if isList && patchedIsList && originalIsList && namedItems(items) && namedItems(patchedItems) && namedItems(originalItems) {
+		return revertItems(items, patchedItems, originalItems)
+	}
+
+	if sameValue(value, patched) {
+		return original
+	}
+
+	return value
+}
+
+// revertItems reverts a list of named objects replaced by the patch, as merge patches replace lists as a whole. Items
+// added by the patch are removed and the ones it replaced are restored, while the ones added since by others are kept.
+func revertItems(items, patched, original []interface{}) []interface{} {
+	reverted := []interface{}{}
+	for _, item := range items {
+		patchedItem, isPatched := findItem(patched, itemName(item))
+		originalItem, existed := findItem(original, itemName(item))
+		switch {
+		case !isPatched:
+			reverted = append(reverted, item)
+		case !existed:
+			// added by the patch
+		case sameValue(item, patchedItem):
+			reverted = append(reverted, originalItem)
+		default:
+			reverted = append(reverted, item)
+		}
+	}
+
+	// items dropped when the patch replaced the list
+	for _, originalItem := range original {
+		name := itemName(originalItem)
+		_, isPatched := findItem(patched, name)
+		_, exists := findItem(items, name)
+		if !isPatched && !exists {
+			reverted = append(reverted, originalItem)
+		}
+	}
+
+	return reverted
+}
+
+// removeFields removes a field added by the patch. Nested fields and list items which have been added since by others
+// are kept, along with the field holding them.
+func removeFields(obj map[string]interface{}, field string, patched interface{}) {
+	value := obj[field]
+	fields, isMap := value.(map[string]interface{})
+	patchedFields, patchedIsMap := patched.(map[string]interface{})
+	items, isList := value.([]interface{})
+	patchedItems, patchedIsList := patched.([]interface{})
+
+	switch {
+	case isMap && patchedIsMap:
+		for patchedField, patchedValue := range patchedFields {
+			removeFields(fields, patchedField, patchedValue)
+		}
+		if len(fields) == 0 {
+			delete(obj, field)
+		}
+	case isList && patchedIsList && namedItems(items) && namedItems(patchedItems):
+		var remaining []interface{}
+		for _, item := range items {
+			if _, isPatched := findItem(patchedItems, itemName(item)); !isPatched {
+				remaining = append(remaining, item)
+			}
+		}
+		if len(remaining) == 0 {
+			delete(obj, field)
+		} else {
+			obj[field] = remaining
+		}
+	case sameValue(value, patched):
+		delete(obj, field)
+	}
+}
+
+// namedItems tells whether all items of the list are objects identified by their name.
+func namedItems(items []interface{}) bool {
+	for _, item := range items {
+		if itemName(item) == "" {
+			return false
+		}
+	}
+
+	return true
+}
+
+func itemName(item interface{}) string {
+	fields, _ := item.(map[string]interface{})
+	name, _ := fields["name"].(string)
+
+	return name
+}
+
+func findItem(items []interface{}, name string) (interface{}, bool) {
+	for _, item := range items {
+		if itemName(item) == name {
+			return item, true
+		}
+	}
+
+	return nil, false
+}
+
+// sameValue compares values regardless of the numeric types used by the JSON decoder and unstructured objects.
+func sameValue(a, b interface{}) bool {
+	aJSON, errA := json.Marshal(a)
+	bJSON, errB := json.Marshal(b)
+
+	return errA == nil && errB == nil && bytes.Equal(aJSON, bJSON)
+}
@@ -0,0 +1,166 @@
+package feature_test
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Reverting patches of features", func() {
+
+	var (
+		ctx context.Context
+		cli client.Client
+		f   *feature.Feature
+	)
+
+	controlPlane := func(spec map[string]interface{}) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(gvk.ServiceMeshControlPlane)
+		obj.SetNamespace("istio-system")
+		obj.SetName("data-science-smcp")
+		if spec != nil {
+			obj.Object["spec"] = spec
+		}
+
+		return obj
+	}
+
+	provider := func(name, service string) map[string]interface{} {
+		return map[string]interface{}{
+			"name":              name,
+			"envoyExtAuthzGrpc": map[string]interface{}{"service": service},
+		}
+	}
+
+	extensionProviders := func() []interface{} {
+		obj := controlPlane(nil)
+		Expect(cli.Get(ctx, client.ObjectKeyFromObject(obj), obj)).To(Succeed())
+		providers, _, err := unstructured.NestedSlice(obj.Object, "spec", "techPreview", "meshConfig", "extensionProviders")
+		Expect(err).ToNot(HaveOccurred())
+
+		return providers
+	}
+
+	setExtensionProviders := func(providers ...interface{}) {
+		obj := controlPlane(nil)
+		Expect(cli.Get(ctx, client.ObjectKeyFromObject(obj), obj)).To(Succeed())
+		Expect(unstructured.SetNestedSlice(obj.Object, providers, "spec", "techPreview", "meshConfig", "extensionProviders")).To(Succeed())
+		Expect(cli.Update(ctx, obj)).To(Succeed())
+	}
+
+	patchControlPlane := func(spec map[string]interface{}) {
+		tracked, err := feature.PatchResources(ctx, cli, []*unstructured.Unstructured{controlPlane(spec)})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(tracked).To(HaveLen(1))
+
+		Expect(feature.RevertPatch(ctx, f, tracked[0])).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		f = &feature.Feature{Name: "mesh-control-plane-external-authz", Log: logr.Discard()}
+	})
+
+	It("should remove the list items added by the patch, keeping the ones added since by others", func() {
+		// given
+		cli = fake.NewClientBuilder().WithScheme(runtime.NewScheme()).WithObjects(controlPlane(map[string]interface{}{
+			"version": "v2.4",
+		})).Build()
+		f.Client = cli
+
+		tracked, err := feature.PatchResources(ctx, cli, []*unstructured.Unstructured{controlPlane(map[string]interface{}{
+			"techPreview": map[string]interface{}{
+				"meshConfig": map[string]interface{}{
+					"extensionProviders": []interface{}{provider("opendatahub-auth-provider", "authorino")},
+				},
+			},
+		})})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(extensionProviders()).To(ConsistOf(provider("opendatahub-auth-provider", "authorino")))
+
+		// when
+		setExtensionProviders(provider("opendatahub-auth-provider", "authorino"), provider("other-provider", "other"))
+		Expect(feature.RevertPatch(ctx, f, tracked[0])).To(Succeed())
+
+		// then
+		Expect(extensionProviders()).To(ConsistOf(provider("other-provider", "other")))
+	})
+
+	It("should restore replaced values and remove added fields, keeping values changed since by others", func() {
+		// given
+		cli = fake.NewClientBuilder().WithScheme(runtime.NewScheme()).WithObjects(controlPlane(map[string]interface{}{
+			"version": "v2.4",
+			"tracing": map[string]interface{}{"type": "None"},
+			"techPreview": map[string]interface{}{
+				"meshConfig": map[string]interface{}{
+					"extensionProviders": []interface{}{provider("existing-provider", "existing")},
+				},
+			},
+		})).Build()
+		f.Client = cli
+
+		tracked, err := feature.PatchResources(ctx, cli, []*unstructured.Unstructured{controlPlane(map[string]interface{}{
+			"version": "v2.5",
+			"tracing": map[string]interface{}{"type": "Jaeger", "sampling": int64(100)},
+			"techPreview": map[string]interface{}{
+				"meshConfig": map[string]interface{}{
+					"extensionProviders": []interface{}{provider("opendatahub-auth-provider", "authorino")},
+				},
+			},
+		})})
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		obj := controlPlane(nil)
+		Expect(cli.Get(ctx, client.ObjectKeyFromObject(obj), obj)).To(Succeed())
+		Expect(unstructured.SetNestedField(obj.Object, "Zipkin", "spec", "tracing", "type")).To(Succeed())
+		Expect(cli.Update(ctx, obj)).To(Succeed())
+
+		Expect(feature.RevertPatch(ctx, f, tracked[0])).To(Succeed())
+
+		// then
+		Expect(cli.Get(ctx, client.ObjectKeyFromObject(obj), obj)).To(Succeed())
+		Expect(obj.Object["spec"]).To(Equal(map[string]interface{}{
+			"version": "v2.4",
+			"tracing": map[string]interface{}{"type": "Zipkin"},
+			"techPreview": map[string]interface{}{
+				"meshConfig": map[string]interface{}{
+					"extensionProviders": []interface{}{provider("existing-provider", "existing")},
+				},
+			},
+		}))
+	})
+
+	It("should remove objects added by the patch once they are empty", func() {
+		// given
+		cli = fake.NewClientBuilder().WithScheme(runtime.NewScheme()).WithObjects(controlPlane(map[string]interface{}{
+			"version": "v2.4",
+		})).Build()
+		f.Client = cli
+
+		// when
+		patchControlPlane(map[string]interface{}{
+			"techPreview": map[string]interface{}{
+				"meshConfig": map[string]interface{}{
+					"extensionProviders": []interface{}{provider("opendatahub-auth-provider", "authorino")},
+				},
+			},
+		})
+
+		// then
+		obj := controlPlane(nil)
+		Expect(cli.Get(ctx, client.ObjectKeyFromObject(obj), obj)).To(Succeed())
+		Expect(obj.Object["spec"]).To(Equal(map[string]interface{}{"version": "v2.4"}))
+	})
+})
@@ -0,0 +1,13 @@
+package serverless
+
+import (
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+)
+
+// Serverless actions can be used by features declared in ConfigMaps.
+func init() { //nolint:gochecknoinits
+	feature.RegisterAction("EnsureServerlessOperatorInstalled", feature.WithoutArgs(EnsureServerlessOperatorInstalled))
+	feature.RegisterAction("EnsureServerlessAbsent", feature.WithoutArgs(EnsureServerlessAbsent))
+	feature.RegisterAction("ServingDefaultValues", feature.WithoutArgs(ServingDefaultValues))
+	feature.RegisterAction("ServingIngressDomain", feature.WithoutArgs(ServingIngressDomain))
+}
@@ -0,0 +1,14 @@
+package servicemesh
+
+import (
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+)
+
+// Service Mesh actions can be used by features declared in ConfigMaps.
+func init() { //nolint:gochecknoinits
+	feature.RegisterAction("EnsureServiceMeshOperatorInstalled", feature.WithoutArgs(EnsureServiceMeshOperatorInstalled))
+	feature.RegisterAction("EnsureServiceMeshInstalled", feature.WithoutArgs(EnsureServiceMeshInstalled))
+	feature.RegisterAction("WaitForControlPlaneToBeReady", feature.WithoutArgs(WaitForControlPlaneToBeReady))
+	feature.RegisterAction("EnsureAuthNamespaceExists", feature.WithoutArgs(EnsureAuthNamespaceExists))
+	feature.RegisterAction("ClusterDetails", feature.WithoutArgs(ClusterDetails))
+}
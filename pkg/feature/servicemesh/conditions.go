@@ -3,12 +3,10 @@ package servicemesh
 import (
 	"context"
 	"fmt"
-	"time"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/util/wait"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
@@ -17,11 +15,6 @@ import (
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/metadata/labels"
 )
 
-const (
-	interval = 2 * time.Second
-	duration = 5 * time.Minute
-)
-
 // EnsureAuthNamespaceExists creates a namespace for the Authorization provider and set ownership so it will be garbage collected when the operator is uninstalled.
 func EnsureAuthNamespaceExists(ctx context.Context, f *feature.Feature) error {
 	if resolveNsErr := ResolveAuthNamespace(f); resolveNsErr != nil {
@@ -49,6 +42,9 @@ func EnsureServiceMeshInstalled(ctx context.Context, f *feature.Feature) error {
 	smcpNs := f.Spec.ControlPlane.Namespace
 
 	if err := WaitForControlPlaneToBeReady(ctx, f); err != nil {
+		if cluster.IsNotReady(err) {
+			return err
+		}
 		f.Log.Error(err, "failed waiting for control plane being ready", "control-plane", smcp, "namespace", smcpNs)
 
 		return multierror.Append(err, errors.New("service mesh control plane is not ready")).ErrorOrNil()
@@ -57,21 +53,23 @@ func EnsureServiceMeshInstalled(ctx context.Context, f *feature.Feature) error {
 	return nil
 }
 
+// WaitForControlPlaneToBeReady checks whether the components of the control plane are ready, returning a
+// cluster.NotReadyError while they are not.
 func WaitForControlPlaneToBeReady(ctx context.Context, f *feature.Feature) error {
 	smcp := f.Spec.ControlPlane.Name
 	smcpNs := f.Spec.ControlPlane.Namespace
 
-	f.Log.Info("waiting for control plane components to be ready", "control-plane", smcp, "namespace", smcpNs, "duration (s)", duration.Seconds())
-
-	return wait.PollUntilContextTimeout(ctx, interval, duration, false, func(ctx context.Context) (bool, error) {
-		ready, err := CheckControlPlaneComponentReadiness(ctx, f.Client, smcp, smcpNs)
+	ready, err := CheckControlPlaneComponentReadiness(ctx, f.Client, smcp, smcpNs)
+	if err != nil {
+		return err
+	}
+	if !ready {
+		f.Log.Info("waiting for control plane components to be ready", "control-plane", smcp, "namespace", smcpNs)
 
-		if ready {
-			f.Log.Info("done waiting for control plane components to be ready", "control-plane", smcp, "namespace", smcpNs)
-		}
+		return cluster.NewNotReadyError("waiting for components of Service Mesh Control Plane %s to be ready in namespace %s", smcp, smcpNs)
+	}
 
-		return ready, err
-	})
+	return nil
 }
 
 func CheckControlPlaneComponentReadiness(ctx context.Context, c client.Client, smcpName, smcpNs string) (bool, error) {
@@ -6,6 +6,7 @@ import (
 
 	"github.com/pkg/errors"
 
+	infrav1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/infrastructure/v1"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
 	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
 )
@@ -26,7 +27,7 @@ func ResolveAuthNamespace(f *feature.Feature) error {
 	dsciAuthNamespace := strings.TrimSpace(f.Spec.Auth.Namespace)
 
 	if len(dsciAuthNamespace) == 0 {
-		f.Spec.Auth.Namespace = f.Spec.AppNamespace + "-auth-provider"
+		f.Spec.Auth.Namespace = infrav1.DefaultAuthNamespace(f.Spec.AppNamespace)
 	}
 
 	return nil
@@ -1,8 +1,10 @@
 package logger
 
 import (
+	"io"
 	"os"
 	"strings"
+	"sync"
 
 	"github.com/go-logr/logr"
 	"go.uber.org/zap/zapcore"
@@ -28,6 +30,27 @@ func LogWithLevel(logger logr.Logger, level string) logr.Logger {
 // in DSC component, to use different mode for logging, e.g. development, production
 // when not set mode it falls to "default" which is used by startup main.go.
 func ConfigLoggers(mode string) logr.Logger {
+	return NewLogger(mode, os.Stdout)
+}
+
+// Loggers holds the loggers configured by ConfigLoggers for each mode, so that components sharing a mode share their
+// logger instead of building one on each reconcile. The zero value is ready to use.
+type Loggers struct {
+	loggers sync.Map
+}
+
+// ForMode returns the logger configured for the given mode, building it the first time the mode is used.
+func (l *Loggers) ForMode(mode string) logr.Logger {
+	if logger, found := l.loggers.Load(mode); found {
+		return logger.(logr.Logger) //nolint:forcetypeassert
+	}
+	logger, _ := l.loggers.LoadOrStore(mode, ConfigLoggers(mode))
+
+	return logger.(logr.Logger) //nolint:forcetypeassert
+}
+
+// NewLogger configures a logger for the given mode, as ConfigLoggers does, writing to dest.
+func NewLogger(mode string, dest io.Writer) logr.Logger {
 	var opts zap.Options
 	switch mode {
 	case "devel", "development": //  the most logging verbosity
@@ -35,14 +58,14 @@ func ConfigLoggers(mode string) logr.Logger {
 			Development:     true,
 			StacktraceLevel: zapcore.WarnLevel,
 			Level:           zapcore.InfoLevel,
-			DestWriter:      os.Stdout,
+			DestWriter:      dest,
 		}
 	case "prod", "production": // the least logging verbosity
 		opts = zap.Options{
 			Development:     false,
 			StacktraceLevel: zapcore.ErrorLevel,
 			Level:           zapcore.InfoLevel,
-			DestWriter:      os.Stdout,
+			DestWriter:      dest,
 			EncoderConfigOptions: []zap.EncoderConfigOption{func(config *zapcore.EncoderConfig) {
 				config.EncodeTime = zapcore.ISO8601TimeEncoder // human readable not epoch
 				config.EncodeDuration = zapcore.SecondsDurationEncoder
@@ -59,7 +82,7 @@ func ConfigLoggers(mode string) logr.Logger {
 			Development:     false,
 			StacktraceLevel: zapcore.ErrorLevel,
 			Level:           zapcore.InfoLevel,
-			DestWriter:      os.Stdout,
+			DestWriter:      dest,
 		}
 	}
 	return zap.New(zap.UseFlagOptions(&opts))
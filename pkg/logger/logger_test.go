@@ -0,0 +1,20 @@
+package logger_test
+
+import (
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/logger"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Loggers of components", func() {
+
+	It("should build a single logger per mode", func() {
+		loggers := &logger.Loggers{}
+
+		devel := loggers.ForMode("devel")
+
+		Expect(loggers.ForMode("devel").GetSink()).To(BeIdenticalTo(devel.GetSink()))
+		Expect(loggers.ForMode("prod").GetSink()).ToNot(BeIdenticalTo(devel.GetSink()))
+	})
+})
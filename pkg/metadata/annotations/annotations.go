@@ -13,3 +13,23 @@ const (
 	SecretLengthAnnotation      = "secret-generator.opendatahub.io/complexity"
 	SecretOauthClientAnnotation = "secret-generator.opendatahub.io/oauth-client-route"
 )
+
+// PlanOnly when set to "true" on a DataScienceCluster makes the operator compute the changes it would perform
+// and report them in the status instead of applying them.
+const PlanOnly = "opendatahub.io/plan-only"
+
+// RespectUserEdits when set to "true" on a resource deployed by the operator makes it keep values of fields changed by
+// other field managers, e.g. edited by users, instead of overwriting them on reconcile.
+const RespectUserEdits = "opendatahub.io/respect-user-edits"
+
+// MigrateMonitoringNamespace when set to "true" on a DSCInitialization allows changing its monitoring namespace, in which
+// case the operator moves the monitoring stack to the new namespace and cleans up the previous one.
+const MigrateMonitoringNamespace = "opendatahub.io/migrate-monitoring-namespace"
+
+// ForceDeletion when set to "true" on a DSCInitialization or a FeatureTracker allows deleting it while objects depending
+// on it still exist.
+const ForceDeletion = "opendatahub.io/force-deletion"
+
+// ComponentsConfig holds the workload configuration of components set with the v2 API of DataScienceCluster, as JSON
+// keyed by component name. It is maintained by the conversion webhook, as the v1 API has no fields for it.
+const ComponentsConfig = "datasciencecluster.opendatahub.io/components-config"
@@ -0,0 +1,83 @@
+package monitoring_test
+
+import (
+	"context"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	dscv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/datasciencecluster/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/components"
+	"github.com/opendatahub-io/opendatahub-operator/v2/components/workbenches"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/monitoring"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Sharing component rules between DataScienceClusters", func() {
+
+	var (
+		ctx          context.Context
+		scheme       *runtime.Scheme
+		owner        *dscv1.DataScienceCluster
+		registration components.Registration
+	)
+
+	dataScienceCluster := func(name string, state operatorv1.ManagementState, installed bool) *dscv1.DataScienceCluster {
+		dsc := &dscv1.DataScienceCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: name, UID: types.UID(name + "-uid")},
+		}
+		dsc.Spec.Components.Workbenches.ManagementState = state
+		dsc.Status.InstalledComponents = map[string]bool{workbenches.ComponentName: installed}
+
+		return dsc
+	}
+
+	installedByOthers := func(objs ...client.Object) bool {
+		cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+		installed, err := monitoring.InstalledByOtherClusters(ctx, cli, owner, registration)
+		Expect(err).ToNot(HaveOccurred())
+
+		return installed
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme = runtime.NewScheme()
+		utilruntime.Must(dscv1.AddToScheme(scheme))
+		owner = dataScienceCluster("owner", operatorv1.Removed, true)
+		var found bool
+		registration, found = components.Lookup(workbenches.ComponentName)
+		Expect(found).To(BeTrue())
+	})
+
+	It("should not keep the rules when no other DataScienceCluster exists", func() {
+		Expect(installedByOthers(owner)).To(BeFalse())
+	})
+
+	It("should keep the rules while another DataScienceCluster has installed the component", func() {
+		Expect(installedByOthers(owner, dataScienceCluster("other", operatorv1.Managed, true))).To(BeTrue())
+	})
+
+	It("should not keep the rules of a component another DataScienceCluster has not installed yet", func() {
+		Expect(installedByOthers(owner, dataScienceCluster("other", operatorv1.Managed, false))).To(BeFalse())
+	})
+
+	It("should not keep the rules of a component removed by the other DataScienceClusters", func() {
+		Expect(installedByOthers(owner, dataScienceCluster("other", operatorv1.Removed, true))).To(BeFalse())
+	})
+
+	It("should not keep the rules of a DataScienceCluster being deleted", func() {
+		deleting := dataScienceCluster("other", operatorv1.Managed, true)
+		deleting.SetFinalizers([]string{"datasciencecluster.opendatahub.io/finalizer"})
+		deleting.SetDeletionTimestamp(&metav1.Time{Time: metav1.Now().Time})
+
+		Expect(installedByOthers(owner, deleting)).To(BeFalse())
+	})
+})
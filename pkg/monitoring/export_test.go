@@ -0,0 +1,5 @@
+package monitoring
+
+// Helpers exposing unexported functions to the tests of the package.
+
+var InstalledByOtherClusters = installedByOtherClusters
@@ -0,0 +1,275 @@
+// Package monitoring deploys the configuration of the Prometheus instance monitoring the components on managed clusters.
+// The configuration is rendered in a workspace, so the manifests shipped in deploy.DefaultManifestPath are never modified.
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"gopkg.in/yaml.v2"
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/components"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster/gvk"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/common"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/deploy"
+)
+
+const (
+	// Folder is the folder of the manifests tree holding the monitoring stack.
+	Folder = "monitoring"
+
+	prometheusConfigFile    = "prometheus-configs.yaml"
+	prometheusConfigMapName = "prometheus"
+	prometheusConfigKey     = "prometheus.yml"
+)
+
+// PrometheusConfigPath is the folder of the Prometheus configuration, relative to Folder.
+var PrometheusConfigPath = filepath.Join("prometheus", "apps")
+
+// prometheusConfigMutex serializes updates of the Prometheus configuration, as components can be reconciled concurrently
+// and each of them updates its own rules in the deployed configuration.
+var prometheusConfigMutex sync.Mutex
+
+// UpdatePrometheusConfig includes the rules of the component in the Prometheus configuration when enable is true, or
+// excludes them otherwise, and deploys the configuration. Rules of the other components are kept as deployed.
+// As the configuration is shared by all DataScienceClusters, rules are only excluded once the component is not
+// installed by any other DataScienceCluster than owner.
+func UpdatePrometheusConfig(ctx context.Context, cli client.Client, owner metav1.Object, componentName string, enable bool) error {
+	registration, found := components.Lookup(componentName)
+	if !found {
+		return fmt.Errorf("component %s is not registered", componentName)
+	}
+	dscispec, err := getDSCISpec(ctx, cli)
+	if err != nil {
+		return err
+	}
+
+	if !enable && dscispec.Monitoring.ManagementState == operatorv1.Managed {
+		if enable, err = installedByOtherClusters(ctx, cli, owner, registration); err != nil {
+			return err
+		}
+	}
+
+	prometheusConfigMutex.Lock()
+	defer prometheusConfigMutex.Unlock()
+
+	rules, err := deployedRules(ctx, cli, dscispec.Monitoring.Namespace)
+	if err != nil {
+		return err
+	}
+	for _, prefix := range registration.MonitoringRules {
+		rules[prefix] = enable
+	}
+
+	return deployPrometheusConfig(ctx, cli, owner, dscispec, rules)
+}
+
+// DeployPrometheusConfig deploys the Prometheus configuration for the given DSCInitialization, keeping the rules of the
+// components as deployed, or without any of them when resetRules is true.
+func DeployPrometheusConfig(ctx context.Context, cli client.Client, dsci *dsciv1.DSCInitialization, resetRules bool) error {
+	prometheusConfigMutex.Lock()
+	defer prometheusConfigMutex.Unlock()
+
+	rules := map[string]bool{}
+	if !resetRules {
+		var err error
+		if rules, err = deployedRules(ctx, cli, dsci.Spec.Monitoring.Namespace); err != nil {
+			return err
+		}
+	}
+
+	return deployPrometheusConfig(ctx, cli, dsci, &dsci.Spec, rules)
+}
+
+func deployPrometheusConfig(ctx context.Context, cli client.Client, owner metav1.Object, dscispec *dsciv1.DSCInitializationSpec,
+	rules map[string]bool,
+) error {
+	workspace, err := deploy.NewWorkspace(prometheusConfigMapName, Folder)
+	if err != nil {
+		return err
+	}
+	defer workspace.Close()
+
+	consolelinkDomain, err := cluster.GetDomain(ctx, cli)
+	if err != nil {
+		return fmt.Errorf("error getting console route URL : %w", err)
+	}
+	configPath := workspace.Path(Folder, PrometheusConfigPath, prometheusConfigFile)
+	err = common.ReplaceStringsInFile(configPath, map[string]string{
+		"<odh_application_namespace>": dscispec.ApplicationsNamespace,
+		"<odh_monitoring_project>":    dscispec.Monitoring.Namespace,
+		"<console_domain>":            consolelinkDomain,
+	})
+	if err != nil {
+		return fmt.Errorf("error rendering %s: %w", prometheusConfigFile, err)
+	}
+	if err := RenderPrometheusConfig(configPath, rules); err != nil {
+		return fmt.Errorf("error rendering %s: %w", prometheusConfigFile, err)
+	}
+
+	return deploy.DeployManifestsFromPath(ctx, cli, owner, workspace.Path(Folder, PrometheusConfigPath),
+		dscispec.Monitoring.Namespace, "prometheus", true)
+}
+
+// prometheusConfigMap mocks the ConfigMap of prometheus-configs.yaml, prometheus.yml being kept as a dynamic struct.
+type prometheusConfigMap struct {
+	APIVersion string `yaml:"apiVersion"`
+	Kind       string `yaml:"kind"`
+	Metadata   struct {
+		Name      string `yaml:"name"`
+		Namespace string `yaml:"namespace"`
+	} `yaml:"metadata"`
+	Data map[string]string `yaml:"data"`
+}
+
+// RenderPrometheusConfig rewrites the rule_files of the Prometheus configuration at path, so that the rules files of
+// components are only included for the prefixes enabled in rules. Other rules files are kept as they are.
+func RenderPrometheusConfig(path string, rules map[string]bool) error {
+	yamlData, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var configMap prometheusConfigMap
+	if err := yaml.Unmarshal(yamlData, &configMap); err != nil {
+		return err
+	}
+	if configMap.Data == nil {
+		configMap.Data = map[string]string{}
+	}
+	var prometheusContent map[interface{}]interface{}
+	if err := yaml.Unmarshal([]byte(configMap.Data[prometheusConfigKey]), &prometheusContent); err != nil {
+		return err
+	}
+	if prometheusContent == nil {
+		prometheusContent = map[interface{}]interface{}{}
+	}
+
+	ruleFiles := []interface{}{}
+	existing, _ := prometheusContent["rule_files"].([]interface{})
+	for _, item := range existing {
+		if rule, isStr := item.(string); isStr && componentRulesPrefix(rule) != "" {
+			continue
+		}
+		ruleFiles = append(ruleFiles, item)
+	}
+	for _, prefix := range sortedEnabled(rules) {
+		ruleFiles = append(ruleFiles, rulesFile(prefix))
+	}
+	prometheusContent["rule_files"] = ruleFiles
+
+	newDataYAML, err := yaml.Marshal(&prometheusContent)
+	if err != nil {
+		return err
+	}
+	configMap.Data[prometheusConfigKey] = string(newDataYAML)
+	newYAMLData, err := yaml.Marshal(&configMap)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, newYAMLData, 0)
+}
+
+// deployedRules returns the prefixes of the rules files of components included in the deployed Prometheus configuration.
+func deployedRules(ctx context.Context, cli client.Client, namespace string) (map[string]bool, error) {
+	rules := map[string]bool{}
+
+	configMap := &corev1.ConfigMap{}
+	if err := cli.Get(ctx, client.ObjectKey{Namespace: namespace, Name: prometheusConfigMapName}, configMap); err != nil {
+		if k8serr.IsNotFound(err) {
+			return rules, nil
+		}
+
+		return nil, fmt.Errorf("error getting configmap %s: %w", prometheusConfigMapName, err)
+	}
+	prometheusContent := struct {
+		RuleFiles []string `yaml:"rule_files"`
+	}{}
+	if err := yaml.Unmarshal([]byte(configMap.Data[prometheusConfigKey]), &prometheusContent); err != nil {
+		return nil, fmt.Errorf("error reading %s of configmap %s: %w", prometheusConfigKey, prometheusConfigMapName, err)
+	}
+	for _, rule := range prometheusContent.RuleFiles {
+		if prefix := componentRulesPrefix(rule); prefix != "" {
+			rules[prefix] = true
+		}
+	}
+
+	return rules, nil
+}
+
+// installedByOtherClusters tells whether the component is Managed and installed by a DataScienceCluster other than owner,
+// which is not being deleted. DataScienceClusters are read as unstructured objects, as their API depends on the components.
+func installedByOtherClusters(ctx context.Context, cli client.Client, owner metav1.Object, registration components.Registration) (bool, error) {
+	instances := &unstructured.UnstructuredList{}
+	instances.SetGroupVersionKind(gvk.DataScienceCluster)
+	if err := cli.List(ctx, instances); err != nil {
+		return false, fmt.Errorf("error listing DataScienceClusters: %w", err)
+	}
+
+	for _, instance := range instances.Items {
+		if instance.GetUID() == owner.GetUID() || instance.GetDeletionTimestamp() != nil {
+			continue
+		}
+		managementState, _, _ := unstructured.NestedString(instance.Object, "spec", "components", registration.Field, "managementState")
+		installed, _, _ := unstructured.NestedBool(instance.Object, "status", "installedComponents", registration.Name)
+		if managementState == string(operatorv1.Managed) && installed {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// componentRulesPrefix returns the prefix of the component the rules file belongs to, empty when the file is not
+// a rules file of a registered component.
+func componentRulesPrefix(rule string) string {
+	for _, registration := range components.Registrations() {
+		for _, prefix := range registration.MonitoringRules {
+			if rule == rulesFile(prefix) {
+				return prefix
+			}
+		}
+	}
+
+	return ""
+}
+
+func rulesFile(prefix string) string {
+	return prefix + "*.rules"
+}
+
+func sortedEnabled(rules map[string]bool) []string {
+	var enabled []string
+	for prefix, enable := range rules {
+		if enable {
+			enabled = append(enabled, prefix)
+		}
+	}
+	sort.Strings(enabled)
+
+	return enabled
+}
+
+func getDSCISpec(ctx context.Context, cli client.Client) (*dsciv1.DSCInitializationSpec, error) {
+	instances := &dsciv1.DSCInitializationList{}
+	if err := cli.List(ctx, instances); err != nil {
+		return nil, fmt.Errorf("error listing DSCInitializations: %w", err)
+	}
+	if len(instances.Items) != 1 {
+		return nil, fmt.Errorf("expected a single DSCInitialization to configure monitoring, found %d", len(instances.Items))
+	}
+
+	return &instances.Items[0].Spec, nil
+}
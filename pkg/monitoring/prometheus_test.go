@@ -0,0 +1,89 @@
+package monitoring_test
+
+import (
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/monitoring"
+
+	_ "github.com/opendatahub-io/opendatahub-operator/v2/components/kueue"
+	_ "github.com/opendatahub-io/opendatahub-operator/v2/components/modelmeshserving"
+	_ "github.com/opendatahub-io/opendatahub-operator/v2/components/workbenches"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const prometheusConfig = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: prometheus
+  namespace: redhat-ods-monitoring
+data:
+  prometheus.yml: |
+    global:
+      scrape_interval: 10s
+    rule_files:
+      - operator-recording.rules
+      - deadmanssnitch-alerting.rules
+      - workbenches*.rules
+  workbenches-alerting.rules: |
+    groups: []
+`
+
+var _ = Describe("Rendering the Prometheus configuration", func() {
+
+	var path string
+
+	BeforeEach(func() {
+		path = filepath.Join(GinkgoT().TempDir(), "prometheus-configs.yaml")
+		Expect(os.WriteFile(path, []byte(prometheusConfig), 0o600)).To(Succeed())
+	})
+
+	ruleFiles := func() []string {
+		content, err := os.ReadFile(path)
+		Expect(err).ToNot(HaveOccurred())
+		configMap := struct {
+			Data map[string]string `yaml:"data"`
+		}{}
+		Expect(yaml.Unmarshal(content, &configMap)).To(Succeed())
+		prometheus := struct {
+			RuleFiles []string `yaml:"rule_files"`
+		}{}
+		Expect(yaml.Unmarshal([]byte(configMap.Data["prometheus.yml"]), &prometheus)).To(Succeed())
+
+		return prometheus.RuleFiles
+	}
+
+	It("should only include the rules of enabled components", func() {
+		Expect(monitoring.RenderPrometheusConfig(path, map[string]bool{"kueue": true, "workbenches": false})).To(Succeed())
+
+		Expect(ruleFiles()).To(Equal([]string{"operator-recording.rules", "deadmanssnitch-alerting.rules", "kueue*.rules"}))
+	})
+
+	It("should include all the rules files of a component", func() {
+		Expect(monitoring.RenderPrometheusConfig(path, map[string]bool{"model-mesh": true, "odh-model-controller": true})).To(Succeed())
+
+		Expect(ruleFiles()).To(Equal([]string{"operator-recording.rules", "deadmanssnitch-alerting.rules",
+			"model-mesh*.rules", "odh-model-controller*.rules"}))
+	})
+
+	It("should remove the rules of all components when none is enabled", func() {
+		Expect(monitoring.RenderPrometheusConfig(path, map[string]bool{})).To(Succeed())
+
+		Expect(ruleFiles()).To(Equal([]string{"operator-recording.rules", "deadmanssnitch-alerting.rules"}))
+	})
+
+	It("should keep the rules definitions and the rest of the configuration", func() {
+		Expect(monitoring.RenderPrometheusConfig(path, map[string]bool{"workbenches": true})).To(Succeed())
+
+		content, err := os.ReadFile(path)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(ContainSubstring("workbenches-alerting.rules"))
+		Expect(string(content)).To(ContainSubstring("scrape_interval: 10s"))
+		Expect(string(content)).To(ContainSubstring("namespace: redhat-ods-monitoring"))
+		Expect(ruleFiles()).To(ContainElement("workbenches*.rules"))
+	})
+})
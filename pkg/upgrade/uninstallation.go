@@ -3,7 +3,7 @@ package upgrade
 import (
 	"context"
 	"fmt"
-	"time"
+	"strings"
 
 	"github.com/hashicorp/go-multierror"
 	corev1 "k8s.io/api/core/v1"
@@ -22,7 +22,8 @@ const (
 )
 
 // OperatorUninstall deletes all the externally generated resources. This includes monitoring resources and applications
-// installed by KfDef.
+// installed by KfDef. It returns a cluster.NotReadyError while the generated namespaces are being deleted, the
+// uninstallation being resumed once they are gone.
 func OperatorUninstall(ctx context.Context, cli client.Client) error {
 	platform, err := cluster.GetPlatform(ctx, cli)
 	if err != nil {
@@ -49,10 +50,11 @@ func OperatorUninstall(ctx context.Context, cli client.Client) error {
 	// Return if any one of the namespaces is Terminating due to resources that are in process of deletion. (e.g. CRDs)
 	for _, namespace := range generatedNamespaces.Items {
 		if namespace.Status.Phase == corev1.NamespaceTerminating {
-			return fmt.Errorf("waiting for namespace %v to be deleted", namespace.Name)
+			return cluster.NewNotReadyError("waiting for namespace %v to be deleted", namespace.Name)
 		}
 	}
 
+	var deletedNamespaces []string
 	for _, namespace := range generatedNamespaces.Items {
 		namespace := namespace
 		if namespace.Status.Phase == corev1.NamespaceActive {
@@ -60,11 +62,14 @@ func OperatorUninstall(ctx context.Context, cli client.Client) error {
 				return fmt.Errorf("error deleting namespace %v: %w", namespace.Name, err)
 			}
 			fmt.Printf("Namespace %s deleted as a part of uninstallation.\n", namespace.Name)
+			deletedNamespaces = append(deletedNamespaces, namespace.Name)
 		}
 	}
 
 	// give enough time for namespace deletion before proceed
-	time.Sleep(10 * time.Second)
+	if len(deletedNamespaces) != 0 {
+		return cluster.NewNotReadyError("waiting for namespaces %s to be deleted", strings.Join(deletedNamespaces, ", "))
+	}
 
 	// We can only assume the subscription is using standard names
 	// if user install by creating different named subs, then we will not know the name
@@ -72,8 +72,9 @@ func CreateDefaultDSC(ctx context.Context, cli client.Client) error {
 				Workbenches: workbenches.Workbenches{
 					Component: components.Component{ManagementState: operatorv1.Managed},
 				},
+				// Can not be enabled at the same time as Kserve
 				ModelMeshServing: modelmeshserving.ModelMeshServing{
-					Component: components.Component{ManagementState: operatorv1.Managed},
+					Component: components.Component{ManagementState: operatorv1.Removed},
 				},
 				DataSciencePipelines: datasciencepipelines.DataSciencePipelines{
 					Component: components.Component{ManagementState: operatorv1.Managed},
@@ -130,9 +131,9 @@ func CreateDefaultDSCI(ctx context.Context, cli client.Client, _ cluster.Platfor
 		ServiceMesh: &infrav1.ServiceMeshSpec{
 			ManagementState: "Managed",
 			ControlPlane: infrav1.ControlPlaneSpec{
-				Name:              "data-science-smcp",
-				Namespace:         "istio-system",
-				MetricsCollection: "Istio",
+				Name:              infrav1.DefaultControlPlaneName,
+				Namespace:         infrav1.DefaultControlPlaneNamespace,
+				MetricsCollection: infrav1.DefaultMetricsCollection,
 			},
 		},
 		TrustedCABundle: &dsciv1.TrustedCABundleSpec{
@@ -0,0 +1,117 @@
+package features_test
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/controllers/status"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/tests/integration/features/fixtures"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Features declared in a ConfigMap", func() {
+
+	const appNamespace = "default"
+
+	var (
+		dsci      *dsciv1.DSCInitialization
+		configMap *corev1.ConfigMap
+	)
+
+	BeforeEach(func() {
+		dsci = fixtures.NewDSCInitialization(appNamespace)
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "declared-features", Namespace: appNamespace},
+			Data: map[string]string{
+				"declared-namespace.feature.yaml": `
+name: declared-namespace
+manifests:
+- namespace.tmpl.yaml
+preconditions:
+- name: CreateNamespaceIfNotExists
+  args: ["declared-precondition-ns"]
+`,
+				"namespace.tmpl.yaml": `
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: declared-{{ .AppNamespace }}-ns
+`,
+			},
+		}
+	})
+
+	It("should apply declared features and remove them once they are not declared anymore", func(ctx context.Context) {
+		// when
+		Expect(feature.DeclaredFeaturesHandler(dsci, configMap, envTest.Config).Apply(ctx)).To(Succeed())
+
+		// then
+		featureTracker, err := fixtures.GetFeatureTracker(ctx, envTestClient, appNamespace, "declared-namespace")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(featureTracker.Spec.Source).To(Equal(featurev1.Source{Type: featurev1.DeclaredType, Name: "declared-features"}))
+		Expect(featureTracker.Status.Phase).To(Equal(status.PhaseReady))
+		Expect(featureTracker.Status.Resources).To(ConsistOf(featurev1.TrackedResource{
+			Version: "v1",
+			Kind:    "Namespace",
+			Name:    "declared-default-ns",
+		}))
+
+		_, err = fixtures.GetNamespace(ctx, envTestClient, "declared-precondition-ns")
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		configMap.Data = map[string]string{}
+		Expect(feature.RemoveUndeclaredFeatures(ctx, envTestClient, dsci, configMap, envTest.Config)).To(Succeed())
+
+		// then
+		_, err = fixtures.GetFeatureTracker(ctx, envTestClient, appNamespace, "declared-namespace")
+		Expect(k8serr.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should not remove declared features while the ConfigMap is invalid", func(ctx context.Context) {
+		// given
+		Expect(feature.DeclaredFeaturesHandler(dsci, configMap, envTest.Config).Apply(ctx)).To(Succeed())
+		defer func() {
+			configMap.Data = map[string]string{}
+			Expect(feature.RemoveUndeclaredFeatures(ctx, envTestClient, dsci, configMap, envTest.Config)).To(Succeed())
+		}()
+
+		// when
+		configMap.Data = map[string]string{"declared-namespace.feature.yaml": "name: declared-namespace\nmanifests: [missing.yaml]"}
+		err := feature.RemoveUndeclaredFeatures(ctx, envTestClient, dsci, configMap, envTest.Config)
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring("manifest missing.yaml is not part of the ConfigMap")))
+		_, err = fixtures.GetFeatureTracker(ctx, envTestClient, appNamespace, "declared-namespace")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should not take over features built into the operator", func(ctx context.Context) {
+		// given
+		featuresHandler := feature.ClusterFeaturesHandler(dsci, func(handler *feature.FeaturesHandler) error {
+			return feature.CreateFeature("built-in-feature").
+				For(handler).
+				UsingConfig(envTest.Config).
+				Load()
+		})
+		Expect(featuresHandler.Apply(ctx)).To(Succeed())
+		defer func() {
+			Expect(featuresHandler.Delete(ctx)).To(Succeed())
+		}()
+
+		// when
+		configMap.Data = map[string]string{"built-in.feature.yaml": "name: built-in-feature"}
+		err := feature.DeclaredFeaturesHandler(dsci, configMap, envTest.Config).Apply(ctx)
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring("feature built-in-feature is already applied by DSCI")))
+	})
+})
@@ -0,0 +1,115 @@
+package features_test
+
+import (
+	"context"
+	"path"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
+	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/cluster"
+	"github.com/opendatahub-io/opendatahub-operator/v2/pkg/feature"
+	"github.com/opendatahub-io/opendatahub-operator/v2/tests/envtestutil"
+	"github.com/opendatahub-io/opendatahub-operator/v2/tests/integration/features/fixtures"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Drift of feature objects", func() {
+
+	var (
+		objectCleaner *envtestutil.Cleaner
+		dsci          *dsciv1.DSCInitialization
+		namespace     *corev1.Namespace
+	)
+
+	BeforeEach(func(ctx context.Context) {
+		objectCleaner = envtestutil.CreateCleaner(envTestClient, envTest.Config, fixtures.Timeout, fixtures.Interval)
+		nsName := envtestutil.AppendRandomNameTo("drift-ns")
+
+		var err error
+		namespace, err = cluster.CreateNamespace(ctx, envTestClient, nsName)
+		Expect(err).ToNot(HaveOccurred())
+
+		dsci = fixtures.NewDSCInitialization(nsName)
+		dsci.Spec.ServiceMesh.ControlPlane.Namespace = namespace.Name
+	})
+
+	AfterEach(func(ctx context.Context) {
+		objectCleaner.DeleteAll(ctx, namespace)
+	})
+
+	applyGatewayFeature := func(ctx context.Context, name string, managed bool) *featurev1.FeatureTracker {
+		featuresHandler := feature.ClusterFeaturesHandler(dsci, func(handler *feature.FeaturesHandler) error {
+			builder := feature.CreateFeature(name).
+				For(handler).
+				UsingConfig(envTest.Config)
+			if managed {
+				builder = builder.Managed()
+			}
+			featureErr := builder.
+				ManifestsLocation(fixtures.TestEmbeddedFiles).
+				Manifests(path.Join(fixtures.BaseDir, "local-gateway-svc.tmpl.yaml")).
+				Load()
+
+			Expect(featureErr).ToNot(HaveOccurred())
+
+			return nil
+		})
+		Expect(featuresHandler.Apply(ctx)).To(Succeed())
+
+		tracker := featurev1.NewFeatureTracker(name, dsci.Spec.ApplicationsNamespace)
+		Expect(envTestClient.Get(ctx, client.ObjectKeyFromObject(tracker), tracker)).To(Succeed())
+
+		return tracker
+	}
+
+	changeGatewayPort := func(ctx context.Context) {
+		service, err := fixtures.GetService(ctx, envTestClient, namespace.Name, "knative-local-gateway")
+		Expect(err).ToNot(HaveOccurred())
+		service.Spec.Ports[0].TargetPort.IntVal = 9090
+		Expect(envTestClient.Update(ctx, service)).To(Succeed())
+	}
+
+	It("should not report drift of objects as applied", func(ctx context.Context) {
+		tracker := applyGatewayFeature(ctx, "drift-in-sync", false)
+
+		drift, err := feature.DetectDrift(ctx, envTestClient, tracker)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(drift.Resources).To(BeEmpty())
+	})
+
+	It("should report changed fields of objects", func(ctx context.Context) {
+		tracker := applyGatewayFeature(ctx, "drift-changed", false)
+		changeGatewayPort(ctx)
+
+		drift, err := feature.DetectDrift(ctx, envTestClient, tracker)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(drift.Managed).To(BeFalse())
+		Expect(drift.Changes).To(ConsistOf("Service " + namespace.Name + "/knative-local-gateway: spec.ports[0].targetPort"))
+	})
+
+	It("should revert changed objects of managed features when applying them again", func(ctx context.Context) {
+		tracker := applyGatewayFeature(ctx, "drift-managed", true)
+		changeGatewayPort(ctx)
+
+		drift, err := feature.DetectDrift(ctx, envTestClient, tracker)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(drift.Managed).To(BeTrue())
+		Expect(drift.Resources).To(HaveLen(1))
+
+		tracker = applyGatewayFeature(ctx, "drift-managed", true)
+		drift, err = feature.DetectDrift(ctx, envTestClient, tracker)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(drift.Resources).To(BeEmpty())
+
+		service := &corev1.Service{}
+		Expect(envTestClient.Get(ctx, client.ObjectKey{Namespace: namespace.Name, Name: "knative-local-gateway"}, service)).To(Succeed())
+		Expect(service.Spec.Ports[0].TargetPort.IntVal).To(BeEquivalentTo(8081))
+	})
+})
@@ -200,9 +200,6 @@ var _ = Describe("Service Mesh setup", func() {
 							For(handler).
 							ManifestsLocation(fixtures.TestEmbeddedFiles).
 							Manifests(path.Join("templates", "mesh-authz-ext-provider.patch.tmpl.yaml")).
-							OnDelete(
-								servicemesh.RemoveExtensionProvider,
-							).
 							UsingConfig(envTest.Config).
 							Load()
 					})
@@ -238,9 +235,9 @@ var _ = Describe("Service Mesh setup", func() {
 							serviceMeshControlPlane, err := getServiceMeshControlPlane(ctx, namespace, name)
 							Expect(err).ToNot(HaveOccurred())
 
-							extensionProviders, found, err := unstructured.NestedSlice(serviceMeshControlPlane.Object, "spec", "techPreview", "meshConfig", "extensionProviders")
+							// the patch is reverted, removing the fields it added
+							extensionProviders, _, err := unstructured.NestedSlice(serviceMeshControlPlane.Object, "spec", "techPreview", "meshConfig", "extensionProviders")
 							Expect(err).ToNot(HaveOccurred())
-							Expect(found).To(BeTrue())
 
 							_, err = fixtures.GetNamespace(ctx, envTestClient, serviceMeshSpec.Auth.Namespace)
 							Expect(errors.IsNotFound(err)).To(BeTrue())
@@ -3,9 +3,13 @@ package features_test
 import (
 	"context"
 	"errors"
+	"path"
 
 	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
 	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	dsciv1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/dscinitialization/v1"
 	featurev1 "github.com/opendatahub-io/opendatahub-operator/v2/apis/features/v1"
@@ -177,4 +181,96 @@ var _ = Describe("Feature tracking capability", func() {
 		})
 
 	})
+
+	Context("listing objects of the feature", func() {
+
+		It("should list created objects in the feature tracker and remove them along with the feature", func(ctx context.Context) {
+			// given
+			featuresHandler := feature.ClusterFeaturesHandler(dsci, func(handler *feature.FeaturesHandler) error {
+				createNamespaceErr := feature.CreateFeature("tracked-namespace").
+					For(handler).
+					UsingConfig(envTest.Config).
+					ManifestsLocation(fixtures.TestEmbeddedFiles).
+					Manifests(path.Join(fixtures.BaseDir, "namespace.yaml")).
+					Load()
+
+				Expect(createNamespaceErr).ToNot(HaveOccurred())
+
+				return nil
+			})
+
+			// when
+			Expect(featuresHandler.Apply(ctx)).To(Succeed())
+
+			// then
+			featureTracker, err := fixtures.GetFeatureTracker(ctx, envTestClient, appNamespace, "tracked-namespace")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(featureTracker.Status.Resources).To(ConsistOf(featurev1.TrackedResource{
+				Version: "v1",
+				Kind:    "Namespace",
+				Name:    "embedded-test-ns",
+			}))
+
+			// when
+			Expect(featuresHandler.Delete(ctx)).To(Succeed())
+
+			// then
+			namespace, err := fixtures.GetNamespace(ctx, envTestClient, "embedded-test-ns")
+			if err == nil {
+				Expect(namespace.DeletionTimestamp).ToNot(BeNil())
+			} else {
+				Expect(k8serr.IsNotFound(err)).To(BeTrue())
+			}
+		})
+
+		It("should revert patches when the feature is removed", func(ctx context.Context) {
+			// given
+			configMap := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "patched-config", Namespace: appNamespace},
+				Data:       map[string]string{"replaced": "original", "kept": "original"},
+			}
+			Expect(envTestClient.Create(ctx, configMap)).To(Succeed())
+			defer func() {
+				Expect(envTestClient.Delete(ctx, configMap)).To(Succeed())
+			}()
+
+			featuresHandler := feature.ClusterFeaturesHandler(dsci, func(handler *feature.FeaturesHandler) error {
+				patchConfigErr := feature.CreateFeature("patched-config").
+					For(handler).
+					UsingConfig(envTest.Config).
+					ManifestsLocation(fixtures.TestEmbeddedFiles).
+					Manifests(path.Join(fixtures.BaseDir, "configmap.patch.tmpl.yaml")).
+					Load()
+
+				Expect(patchConfigErr).ToNot(HaveOccurred())
+
+				return nil
+			})
+
+			// when
+			Expect(featuresHandler.Apply(ctx)).To(Succeed())
+
+			// then
+			Expect(envTestClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+			Expect(configMap.Data).To(Equal(map[string]string{"replaced": "patched", "kept": "original", "added": "patched"}))
+			Expect(configMap.Labels).To(HaveKeyWithValue("opendatahub.io/patched", "true"))
+
+			featureTracker, err := fixtures.GetFeatureTracker(ctx, envTestClient, appNamespace, "patched-config")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(featureTracker.Status.Resources).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Kind":         Equal("ConfigMap"),
+				"Name":         Equal("patched-config"),
+				"Patch":        BeTrue(),
+				"ReversePatch": Not(BeEmpty()),
+			})))
+
+			// when
+			Expect(featuresHandler.Delete(ctx)).To(Succeed())
+
+			// then
+			Expect(envTestClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+			Expect(configMap.Data).To(Equal(map[string]string{"replaced": "original", "kept": "original"}))
+			Expect(configMap.Labels).ToNot(HaveKey("opendatahub.io/patched"))
+		})
+	})
 })